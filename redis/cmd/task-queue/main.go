@@ -35,7 +35,7 @@ func main() {
 	defer logger.Sync()
 	zap.ReplaceGlobals(logger)
 
-	redisClient, err := redis.NewClient(ctx, cfg.Redis.Addr)
+	redisClient, err := redis.NewClient(ctx, cfg.Redis.Addr, queue.BlockingConns(cfg))
 	if err != nil {
 		logger.Fatal("Failed to initialize Redis client: %v", zap.Error(err))
 	}
@@ -43,9 +43,27 @@ func main() {
 
 	metrics := metrics.NewMetrics(redisClient, cfg, logger)
 
-	tq := queue.NewTaskQueue(redisClient, metrics, cfg, logger)
+	registry := queue.NewRegistry()
+	registry.Register(queue.DefaultTaskType, func(ctx context.Context, task queue.Task) (string, error) {
+		// Имитация обработки
+		select {
+		case <-time.After(100 * time.Millisecond):
+			return "processed: " + task.Payload, nil
+		case <-ctx.Done():
+			return "", context.Cause(ctx)
+		}
+	})
+
+	tq := queue.NewTaskQueue(redisClient, metrics, registry, cfg, logger)
+	if err := tq.MigrateLegacy(ctx); err != nil {
+		logger.Fatal("Failed to migrate legacy data", zap.Error(err))
+	}
 
-	go tq.ProcessTasks(ctx)
+	stopped := make(chan struct{})
+	go func() {
+		tq.ProcessTasks(ctx)
+		close(stopped)
+	}()
 
 	handler := api.NewHandler(tq, cfg, logger)
 	srv := &http.Server{
@@ -68,7 +86,12 @@ func main() {
 
 	cancel()
 
-	time.Sleep(1 * time.Second)
+	// Ждём, пока воркеры вернут прерванные задачи в очередь
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		logger.Warn("Timed out waiting for workers to stop")
+	}
 
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer shutdownCancel()
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"task-queue/internal/queue"
+
+	"go.uber.org/zap"
+)
+
+// errBatchTooLarge возвращается decodeBatch, если в теле больше задач, чем разрешено
+var errBatchTooLarge = errors.New("batch is too large")
+
+// BatchItemResult итог добавления одной задачи пакета
+type BatchItemResult struct {
+	Index  int    `json:"index"`  // Позиция задачи в запросе
+	Status int    `json:"status"` // Код, которым ответил бы POST /tasks для этой задачи
+	ID     string `json:"id,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BatchResponse ответ на пакетное добавление задач
+type BatchResponse struct {
+	Added      int               `json:"added"`
+	Duplicates int               `json:"duplicates"`
+	Failed     int               `json:"failed"`
+	Items      []BatchItemResult `json:"items"`
+}
+
+// addTasks обрабатывает POST /tasks/batch. Тело — JSON-массив TaskRequest
+// или NDJSON (по одному TaskRequest в строке). Каждая задача проверяется
+// и добавляется независимо, итог возвращается по каждой. Тело читается не дальше
+// http.max_batch_bytes и http.max_batch_size задач, поэтому большой запрос не занимает память целиком
+func (h *Handler) addTasks(w http.ResponseWriter, r *http.Request) {
+	if h.cfg.HTTP.MaxBatchBytes > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, h.cfg.HTTP.MaxBatchBytes)
+	}
+	entries, err := decodeBatch(r.Body, h.cfg.HTTP.MaxBatchSize)
+	var maxBytesErr *http.MaxBytesError
+	if errors.Is(err, errBatchTooLarge) || errors.As(err, &maxBytesErr) {
+		h.logger.Warn("Batch is too large",
+			zap.Int("size", len(entries)),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Batch is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Invalid request body",
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	if len(entries) == 0 {
+		http.Error(w, "Batch is empty", http.StatusBadRequest)
+		return
+	}
+
+	resp := BatchResponse{Items: make([]BatchItemResult, len(entries))}
+	var specs []queue.TaskSpec
+	var indices []int
+	for i, entry := range entries {
+		resp.Items[i] = BatchItemResult{Index: i, Status: http.StatusBadRequest}
+
+		var req TaskRequest
+		if err := json.Unmarshal(entry, &req); err != nil {
+			resp.Items[i].Error = "Invalid request body"
+			continue
+		}
+		if msg := h.validateTask(req); msg != "" {
+			resp.Items[i].Error = msg
+			continue
+		}
+
+		specs = append(specs, queue.TaskSpec{
+			Type:      req.Type,
+			Payload:   req.Payload,
+			Priority:  req.Priority,
+			ExecuteAt: req.ExecuteAt,
+			Options:   req.options(),
+		})
+		indices = append(indices, i)
+	}
+
+	if len(specs) > 0 {
+		results, err := h.queue.AddTasks(r.Context(), specs)
+		if err != nil {
+			h.logger.Error("Failed to add tasks",
+				zap.Int("size", len(specs)),
+				zap.String("remote_addr", r.RemoteAddr),
+				zap.Error(err))
+			http.Error(w, "Failed to add tasks", http.StatusInternalServerError)
+			return
+		}
+
+		for j, result := range results {
+			item := &resp.Items[indices[j]]
+			switch {
+			case result.Err != nil:
+				item.Status = http.StatusInternalServerError
+				item.Error = "Failed to add task"
+			case result.Info.Duplicate:
+				item.Status = http.StatusOK
+				item.ID = result.Info.ID
+			default:
+				item.Status = http.StatusCreated
+				item.ID = result.Info.ID
+			}
+		}
+	}
+
+	for _, item := range resp.Items {
+		switch item.Status {
+		case http.StatusCreated:
+			resp.Added++
+		case http.StatusOK:
+			resp.Duplicates++
+		default:
+			resp.Failed++
+		}
+	}
+
+	h.logger.Info("Batch creation request processed",
+		zap.Int("added", resp.Added),
+		zap.Int("duplicates", resp.Duplicates),
+		zap.Int("failed", resp.Failed),
+		zap.String("remote_addr", r.RemoteAddr))
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// decodeBatch разбирает тело пакетного запроса на отдельные задачи, не декодируя их.
+// Встретив задачу сверх limit (0 — без ограничения), прекращает чтение и возвращает errBatchTooLarge
+func decodeBatch(body io.Reader, limit int) ([]json.RawMessage, error) {
+	reader := bufio.NewReader(body)
+
+	// JSON-массив начинается с '[', иначе считаем тело NDJSON
+	first, err := peekNonSpace(reader)
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []json.RawMessage
+	add := func(entry json.RawMessage) error {
+		if limit > 0 && len(entries) == limit {
+			return errBatchTooLarge
+		}
+		entries = append(entries, entry)
+		return nil
+	}
+
+	if first == '[' {
+		decoder := json.NewDecoder(reader)
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		for decoder.More() {
+			var entry json.RawMessage
+			if err := decoder.Decode(&entry); err != nil {
+				return nil, err
+			}
+			if err := add(entry); err != nil {
+				return nil, err
+			}
+		}
+		// Закрывающая скобка: без неё массив оборван
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		return entries, nil
+	}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := add(json.RawMessage(bytes.Clone(line))); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// peekNonSpace пропускает пробельные символы и возвращает следующий байт, не извлекая его
+func peekNonSpace(reader *bufio.Reader) (byte, error) {
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if b != ' ' && b != '\t' && b != '\r' && b != '\n' {
+			return b, reader.UnreadByte()
+		}
+	}
+}
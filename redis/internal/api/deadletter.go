@@ -0,0 +1,194 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"task-queue/internal/queue"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultDeadPageLimit = 50   // Размер страницы GET /admin/dlq по умолчанию
+	maxDeadPageLimit     = 1000 // Наибольший размер страницы GET /admin/dlq
+)
+
+// RequeueResponse ответ на повтор задач из dead_letter_queue
+type RequeueResponse struct {
+	Requeued int64 `json:"requeued"`
+}
+
+// PurgeResponse ответ на удаление задач из dead_letter_queue
+type PurgeResponse struct {
+	Purged int64 `json:"purged"`
+}
+
+// listDeadTasks обрабатывает GET /admin/dlq?offset=&limit=
+func (h *Handler) listDeadTasks(w http.ResponseWriter, r *http.Request) {
+	offset, limit, ok := pagination(r)
+	if !ok {
+		h.logger.Warn("Invalid pagination",
+			zap.String("query", r.URL.RawQuery),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Invalid pagination", http.StatusBadRequest)
+		return
+	}
+
+	page, err := h.queue.ListDeadTasks(r.Context(), offset, limit)
+	if err != nil {
+		h.logger.Error("Failed to list dead tasks",
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Failed to list dead tasks", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+// getDeadTask обрабатывает GET /admin/dlq/{id}
+func (h *Handler) getDeadTask(w http.ResponseWriter, r *http.Request, taskID string) {
+	task, err := h.queue.GetDeadTask(r.Context(), taskID)
+	if errors.Is(err, queue.ErrTaskNotFound) {
+		h.logger.Warn("Task not found in dead letter queue",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Task not found in dead letter queue", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to get dead task",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Failed to get dead task", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, task)
+}
+
+// requeueDeadTask обрабатывает POST /admin/dlq/{id}/requeue
+func (h *Handler) requeueDeadTask(w http.ResponseWriter, r *http.Request, taskID string) {
+	info, err := h.queue.RequeueDeadTask(r.Context(), taskID)
+	switch {
+	case errors.Is(err, queue.ErrTaskNotFound):
+		h.logger.Warn("Task not found in dead letter queue",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Task not found in dead letter queue", http.StatusNotFound)
+		return
+	case errors.Is(err, queue.ErrTaskCorrupt):
+		h.logger.Warn("Task record is corrupt",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Task record is corrupt", http.StatusConflict)
+		return
+	case errors.Is(err, queue.ErrUniqueKeyTaken):
+		h.logger.Warn("Unique key of dead task is held by another task",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Task with the same unique key is already active", http.StatusConflict)
+		return
+	case err != nil:
+		h.logger.Error("Failed to requeue dead task",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Failed to requeue dead task", http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info("Dead task requeue request processed",
+		zap.String("task_id", taskID),
+		zap.String("remote_addr", r.RemoteAddr))
+
+	writeJSON(w, http.StatusOK, info)
+}
+
+// requeueDeadTasks обрабатывает POST /admin/dlq/requeue
+func (h *Handler) requeueDeadTasks(w http.ResponseWriter, r *http.Request) {
+	requeued, err := h.queue.RequeueDeadTasks(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to requeue dead tasks",
+			zap.Int64("requeued", requeued),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Failed to requeue dead tasks", http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info("Dead tasks requeue request processed",
+		zap.Int64("requeued", requeued),
+		zap.String("remote_addr", r.RemoteAddr))
+
+	writeJSON(w, http.StatusOK, RequeueResponse{Requeued: requeued})
+}
+
+// purgeDeadTask обрабатывает DELETE /admin/dlq/{id}
+func (h *Handler) purgeDeadTask(w http.ResponseWriter, r *http.Request, taskID string) {
+	err := h.queue.PurgeDeadTask(r.Context(), taskID)
+	if errors.Is(err, queue.ErrTaskNotFound) {
+		h.logger.Warn("Task not found in dead letter queue",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Task not found in dead letter queue", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to purge dead task",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Failed to purge dead task", http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info("Dead task purge request processed",
+		zap.String("task_id", taskID),
+		zap.String("remote_addr", r.RemoteAddr))
+
+	writeJSON(w, http.StatusOK, PurgeResponse{Purged: 1})
+}
+
+// purgeDeadTasks обрабатывает DELETE /admin/dlq
+func (h *Handler) purgeDeadTasks(w http.ResponseWriter, r *http.Request) {
+	purged, err := h.queue.PurgeDeadTasks(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to purge dead tasks",
+			zap.Int64("purged", purged),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Failed to purge dead tasks", http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info("Dead tasks purge request processed",
+		zap.Int64("purged", purged),
+		zap.String("remote_addr", r.RemoteAddr))
+
+	writeJSON(w, http.StatusOK, PurgeResponse{Purged: purged})
+}
+
+// pagination разбирает параметры offset и limit запроса
+func pagination(r *http.Request) (offset, limit int, ok bool) {
+	offset, limit = 0, defaultDeadPageLimit
+	query := r.URL.Query()
+	if value := query.Get("offset"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return 0, 0, false
+		}
+		offset = n
+	}
+	if value := query.Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 || n > maxDeadPageLimit {
+			return 0, 0, false
+		}
+		limit = n
+	}
+	return offset, limit, true
+}
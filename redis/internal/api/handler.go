@@ -2,7 +2,9 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strings"
 	"time"
 
 	"task-queue/internal/config"
@@ -27,9 +29,62 @@ func NewHandler(queue queue.ITaskQueue, cfg *config.Config, logger *zap.Logger)
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodPost:
-		if r.URL.Path == "/tasks" {
+		switch r.URL.Path {
+		case "/tasks":
 			h.addTask(w, r)
 			return
+		case "/tasks/batch":
+			h.addTasks(w, r)
+			return
+		case "/admin/dlq/requeue":
+			h.requeueDeadTasks(w, r)
+			return
+		}
+		if path, ok := strings.CutSuffix(r.URL.Path, "/requeue"); ok {
+			if taskID, ok := taskIDFromPath(path, "/admin/dlq/"); ok {
+				h.requeueDeadTask(w, r, taskID)
+				return
+			}
+		}
+		if name, shard, pause, ok := queuePauseFromPath(r.URL.Path); ok {
+			h.pauseQueue(w, r, name, shard, pause)
+			return
+		}
+	case http.MethodGet:
+		switch r.URL.Path {
+		case "/admin/dlq":
+			h.listDeadTasks(w, r)
+			return
+		case "/admin/queues":
+			h.listQueues(w, r)
+			return
+		}
+		if taskID, ok := taskIDFromPath(r.URL.Path, "/admin/dlq/"); ok {
+			h.getDeadTask(w, r, taskID)
+			return
+		}
+		if path, ok := strings.CutSuffix(r.URL.Path, "/result"); ok {
+			if taskID, ok := taskIDFromPath(path, "/tasks/"); ok {
+				h.getResult(w, r, taskID)
+				return
+			}
+		}
+		if taskID, ok := taskIDFromPath(r.URL.Path, "/tasks/"); ok {
+			h.getTask(w, r, taskID)
+			return
+		}
+	case http.MethodDelete:
+		if r.URL.Path == "/admin/dlq" {
+			h.purgeDeadTasks(w, r)
+			return
+		}
+		if taskID, ok := taskIDFromPath(r.URL.Path, "/admin/dlq/"); ok {
+			h.purgeDeadTask(w, r, taskID)
+			return
+		}
+		if taskID, ok := taskIDFromPath(r.URL.Path, "/tasks/"); ok {
+			h.cancelTask(w, r, taskID)
+			return
 		}
 	}
 
@@ -41,9 +96,60 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 // TaskRequest представляет запрос для добавления задачи
 type TaskRequest struct {
+	Type      string    `json:"type"`
 	Payload   string    `json:"payload"`
 	Priority  int       `json:"priority"`
 	ExecuteAt time.Time `json:"execute_at"`
+	// Ключ идемпотентности, защищающий от задвоения задачи при повторе запроса;
+	// можно передать и заголовком Idempotency-Key
+	IdempotencyKey string `json:"idempotency_key"`
+	// Ключ уникальности: пока задача с ним не завершена, новая с ней сливается
+	UniqueKey string `json:"unique_key"`
+	UniqueFor int    `json:"unique_for"` // Окно уникальности, мс; 0 — до завершения задачи
+	// Политика повторов задачи; незаданные поля берутся из конфигурации retry
+	Retry   *queue.RetryPolicy `json:"retry"`
+	Timeout int                `json:"timeout"` // Сколько может выполняться попытка, мс; 0 — по типу задачи или конфигурации
+	Queue   string             `json:"queue"`   // Именованная очередь из named_queues; пустая строка — default
+}
+
+// options возвращает необязательные параметры добавления задачи
+func (req TaskRequest) options() queue.TaskOptions {
+	return queue.TaskOptions{
+		IdempotencyKey: req.IdempotencyKey,
+		UniqueKey:      req.UniqueKey,
+		UniqueFor:      time.Duration(req.UniqueFor) * time.Millisecond,
+		Retry:          req.Retry,
+		Timeout:        time.Duration(req.Timeout) * time.Millisecond,
+		Queue:          req.Queue,
+	}
+}
+
+// validateTask проверяет запрос на добавление задачи и возвращает текст ошибки для клиента
+func (h *Handler) validateTask(req TaskRequest) string {
+	switch {
+	case req.Payload == "":
+		return "Payload is required"
+	case req.Priority < h.cfg.Priorities.Low || req.Priority > h.cfg.Priorities.High:
+		return "Invalid priority"
+	case req.UniqueFor < 0:
+		return "Invalid unique_for"
+	case req.Retry != nil && !req.Retry.Valid():
+		return "Invalid retry policy"
+	case req.Timeout < 0:
+		return "Invalid timeout"
+	case !h.knownQueue(req.Queue):
+		return "Unknown queue"
+	}
+	return ""
+}
+
+// knownQueue проверяет, что очередь объявлена в конфигурации
+func (h *Handler) knownQueue(name string) bool {
+	if name == "" || name == queue.DefaultQueue {
+		return true
+	}
+	_, ok := h.cfg.NamedQueues[name]
+	return ok
 }
 
 // addTask обрабатывает POST /tasks
@@ -58,24 +164,24 @@ func (h *Handler) addTask(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Валидация
-	if req.Payload == "" {
-		h.logger.Warn("Payload is required",
-			zap.String("remote_addr", r.RemoteAddr))
-		http.Error(w, "Payload is required", http.StatusBadRequest)
-		return
-	}
-	if req.Priority < h.cfg.Priorities.Low || req.Priority > h.cfg.Priorities.High {
-		h.logger.Warn("Invalid priority",
+	if msg := h.validateTask(req); msg != "" {
+		h.logger.Warn(msg,
 			zap.Int("priority", req.Priority),
+			zap.Int("unique_for", req.UniqueFor),
 			zap.String("remote_addr", r.RemoteAddr))
-		http.Error(w, "Invalid priority", http.StatusBadRequest)
+		http.Error(w, msg, http.StatusBadRequest)
 		return
 	}
 
+	if req.IdempotencyKey == "" {
+		req.IdempotencyKey = r.Header.Get("Idempotency-Key")
+	}
+
 	// Добавляем задачу
-	err := h.queue.AddTask(r.Context(), req.Payload, req.Priority, req.ExecuteAt)
+	info, err := h.queue.AddTask(r.Context(), req.Type, req.Payload, req.Priority, req.ExecuteAt, req.options())
 	if err != nil {
 		h.logger.Error("Failed to add task",
+			zap.String("type", req.Type),
 			zap.String("payload", req.Payload),
 			zap.Int("priority", req.Priority),
 			zap.String("remote_addr", r.RemoteAddr),
@@ -85,11 +191,136 @@ func (h *Handler) addTask(w http.ResponseWriter, r *http.Request) {
 	}
 
 	h.logger.Info("Task creation request processed",
+		zap.String("task_id", info.ID),
+		zap.String("type", req.Type),
 		zap.String("payload", req.Payload),
 		zap.Int("priority", req.Priority),
+		zap.Bool("duplicate", info.Duplicate),
+		zap.String("remote_addr", r.RemoteAddr))
+
+	// Повтор запроса или задача с тем же ключом уникальности не создают новую задачу
+	if info.Duplicate {
+		writeJSON(w, http.StatusOK, info)
+		return
+	}
+	writeJSON(w, http.StatusCreated, info)
+}
+
+// getTask обрабатывает GET /tasks/{id}
+func (h *Handler) getTask(w http.ResponseWriter, r *http.Request, taskID string) {
+	info, err := h.queue.GetTask(r.Context(), taskID)
+	if errors.Is(err, queue.ErrTaskNotFound) {
+		h.logger.Warn("Task not found",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Task not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to get task",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Failed to get task", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, info)
+}
+
+// getResult обрабатывает GET /tasks/{id}/result
+func (h *Handler) getResult(w http.ResponseWriter, r *http.Request, taskID string) {
+	result, err := h.queue.GetResult(r.Context(), taskID)
+	switch {
+	case errors.Is(err, queue.ErrTaskNotFound):
+		h.logger.Warn("Task not found",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Task not found", http.StatusNotFound)
+		return
+	case errors.Is(err, queue.ErrResultNotReady):
+		http.Error(w, "Task result is not ready", http.StatusAccepted)
+		return
+	case errors.Is(err, queue.ErrTaskFailed):
+		http.Error(w, "Task failed", http.StatusConflict)
+		return
+	case errors.Is(err, queue.ErrTaskCancelled):
+		http.Error(w, "Task was cancelled", http.StatusConflict)
+		return
+	case errors.Is(err, queue.ErrResultExpired):
+		http.Error(w, "Task result expired", http.StatusGone)
+		return
+	case errors.Is(err, queue.ErrResultNotStored):
+		http.Error(w, "Task result is not stored", http.StatusConflict)
+		return
+	case err != nil:
+		h.logger.Error("Failed to get task result",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Failed to get task result", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// cancelTask обрабатывает DELETE /tasks/{id}
+func (h *Handler) cancelTask(w http.ResponseWriter, r *http.Request, taskID string) {
+	info, err := h.queue.CancelTask(r.Context(), taskID)
+	switch {
+	case errors.Is(err, queue.ErrTaskNotFound):
+		h.logger.Warn("Task not found",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Task not found", http.StatusNotFound)
+		return
+	case errors.Is(err, queue.ErrTaskInFlight):
+		h.logger.Warn("Task is already running",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Task is already running", http.StatusConflict)
+		return
+	case errors.Is(err, queue.ErrTaskDead):
+		h.logger.Warn("Task is in dead letter queue",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Task is in dead letter queue", http.StatusConflict)
+		return
+	case errors.Is(err, queue.ErrTaskFinished):
+		h.logger.Warn("Task is already finished",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Task is already finished", http.StatusConflict)
+		return
+	case err != nil:
+		h.logger.Error("Failed to cancel task",
+			zap.String("task_id", taskID),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Failed to cancel task", http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info("Task cancellation request processed",
+		zap.String("task_id", taskID),
 		zap.String("remote_addr", r.RemoteAddr))
 
+	writeJSON(w, http.StatusOK, info)
+}
+
+// taskIDFromPath извлекает идентификатор задачи из пути вида {prefix}{id}, например /tasks/{id}
+func taskIDFromPath(path, prefix string) (string, bool) {
+	taskID, ok := strings.CutPrefix(path, prefix)
+	if !ok || taskID == "" || strings.Contains(taskID, "/") {
+		return "", false
+	}
+	return taskID, true
+}
+
+// writeJSON отправляет ответ в формате JSON с заданным статусом
+func writeJSON(w http.ResponseWriter, status int, body any) {
 	w.Header().Add("Content-Type", "application/json; charset=utf-8")
-	w.WriteHeader(http.StatusCreated)
-	json.NewEncoder(w).Encode(map[string]string{"status": "task added"})
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
 }
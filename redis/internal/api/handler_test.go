@@ -6,11 +6,13 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"task-queue/internal/config"
 	"task-queue/internal/mocks"
+	"task-queue/internal/queue"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
@@ -26,16 +28,64 @@ func TestHandler_ServeHTTP(t *testing.T) {
 			Medium: 2,
 			High:   3,
 		},
+		NamedQueues: map[string]config.QueueConfig{
+			"email": {Shards: 2},
+		},
+		HTTP: config.HTTPConfig{
+			MaxBatchSize:  4,
+			MaxBatchBytes: 1024,
+		},
 	}
 
 	mockQueue := mocks.NewITaskQueueMock(mc)
 	handler := NewHandler(mockQueue, cfg, zap.L())
 
+	nextExecutionAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	taskInfo := &queue.TaskInfo{
+		ID:              "task-1",
+		Type:            "default",
+		Shard:           2,
+		State:           queue.TaskStateScheduled,
+		Priority:        2,
+		NextExecutionAt: &nextExecutionAt,
+		Transitions: []queue.TransitionInfo{
+			{State: queue.TaskStateScheduled, At: time.Date(2025, 1, 2, 3, 4, 0, 0, time.UTC)},
+		},
+	}
+	taskInfoBody := "{\"id\":\"task-1\",\"type\":\"default\",\"shard\":2,\"state\":\"scheduled\",\"priority\":2,\"attempts\":0,\"next_execution_at\":\"2025-01-02T03:04:05Z\",\"transitions\":[{\"state\":\"scheduled\",\"at\":\"2025-01-02T03:04:00Z\"}]}\n"
+
+	failedAt := time.Date(2025, 1, 2, 3, 5, 0, 0, time.UTC)
+	deadTask := &queue.DeadTask{
+		TaskInfo: &queue.TaskInfo{
+			ID:        "task-2",
+			Type:      "default",
+			Shard:     1,
+			State:     queue.TaskStateDead,
+			Priority:  1,
+			Attempts:  3,
+			LastError: "upstream unavailable",
+			FailedAt:  &failedAt,
+			History: []queue.AttemptInfo{
+				{StartedAt: failedAt.Add(-time.Second), Duration: 1000, Error: "upstream unavailable", Worker: "host:1"},
+			},
+		},
+		Payload: "Dead task",
+	}
+	deadTaskBody := "{\"id\":\"task-2\",\"type\":\"default\",\"shard\":1,\"state\":\"dead\",\"priority\":1,\"attempts\":3," +
+		"\"last_error\":\"upstream unavailable\",\"failed_at\":\"2025-01-02T03:05:00Z\"," +
+		"\"history\":[{\"started_at\":\"2025-01-02T03:04:59Z\",\"duration\":1000,\"error\":\"upstream unavailable\",\"worker\":\"host:1\"}]," +
+		"\"payload\":\"Dead task\"}\n"
+
+	pausedAt := time.Date(2025, 1, 2, 3, 6, 0, 0, time.UTC)
+	emailStatus := &queue.QueueStatus{Name: "email", Shards: 2, Paused: true, PausedAt: &pausedAt, PausedShards: []int{}}
+	emailStatusBody := "{\"name\":\"email\",\"shards\":2,\"paused\":true,\"paused_at\":\"2025-01-02T03:06:00Z\",\"paused_shards\":[]}\n"
+
 	tests := []struct {
 		name           string
 		method         string
 		path           string
 		body           interface{}
+		headers        map[string]string
 		expectedStatus int
 		expectedBody   string
 		setupMock      func()
@@ -46,9 +96,9 @@ func TestHandler_ServeHTTP(t *testing.T) {
 			path:           "/tasks",
 			body:           TaskRequest{Payload: "Test task", Priority: 2, ExecuteAt: time.Now().Add(5 * time.Second)},
 			expectedStatus: http.StatusCreated,
-			expectedBody:   "{\"status\":\"task added\"}\n",
+			expectedBody:   taskInfoBody,
 			setupMock: func() {
-				mockQueue.AddTaskMock.Return(nil)
+				mockQueue.AddTaskMock.Return(taskInfo, nil)
 			},
 		},
 		{
@@ -87,6 +137,51 @@ func TestHandler_ServeHTTP(t *testing.T) {
 			expectedBody:   "Invalid priority\n",
 			setupMock:      func() {},
 		},
+		{
+			name:           "Invalid unique_for",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           TaskRequest{Payload: "Test task", Priority: 2, UniqueKey: "reindex:42", UniqueFor: -1},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Invalid unique_for\n",
+			setupMock:      func() {},
+		},
+		{
+			name:           "Invalid retry policy",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           TaskRequest{Payload: "Test task", Priority: 2, Retry: &queue.RetryPolicy{Jitter: 1.5}},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Invalid retry policy\n",
+			setupMock:      func() {},
+		},
+		{
+			name:           "Invalid timeout",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           TaskRequest{Payload: "Test task", Priority: 2, Timeout: -1},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Invalid timeout\n",
+			setupMock:      func() {},
+		},
+		{
+			name:           "Unknown queue",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           TaskRequest{Payload: "Test task", Priority: 2, Queue: "billing"},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Unknown queue\n",
+			setupMock:      func() {},
+		},
+		{
+			name:           "Fixed retry policy without delays",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           TaskRequest{Payload: "Test task", Priority: 2, Retry: &queue.RetryPolicy{Type: queue.RetryFixed}},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Invalid retry policy\n",
+			setupMock:      func() {},
+		},
 		{
 			name:           "AddTask error",
 			method:         http.MethodPost,
@@ -96,8 +191,612 @@ func TestHandler_ServeHTTP(t *testing.T) {
 			expectedBody:   "Failed to add task\n",
 			setupMock: func() {
 				mockQueue.AddTaskMock.
-					Return(errors.New("failed to add task"))
+					Return(nil, errors.New("failed to add task"))
+			},
+		},
+		{
+			name:           "POST /tasks with idempotency key",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           TaskRequest{Payload: "Test task", Priority: 2, IdempotencyKey: "key-1"},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   taskInfoBody,
+			setupMock: func() {
+				mockQueue.AddTaskMock.
+					Expect(minimock.AnyContext, "", "Test task", 2, time.Time{}, queue.TaskOptions{IdempotencyKey: "key-1"}).
+					Return(taskInfo, nil)
+			},
+		},
+		{
+			name:           "POST /tasks with unique key",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           TaskRequest{Payload: "Test task", Priority: 2, UniqueKey: "reindex:42", UniqueFor: 60000},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   taskInfoBody,
+			setupMock: func() {
+				mockQueue.AddTaskMock.
+					Expect(minimock.AnyContext, "", "Test task", 2, time.Time{}, queue.TaskOptions{UniqueKey: "reindex:42", UniqueFor: time.Minute}).
+					Return(taskInfo, nil)
+			},
+		},
+		{
+			name:           "POST /tasks with retry policy",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           `{"payload":"Test task","priority":2,"retry":{"max_attempts":10,"backoff_initial":1000,"backoff_max":60000,"jitter":0.5}}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   taskInfoBody,
+			setupMock: func() {
+				mockQueue.AddTaskMock.
+					Expect(minimock.AnyContext, "", "Test task", 2, time.Time{}, queue.TaskOptions{
+						Retry: &queue.RetryPolicy{MaxAttempts: 10, BackoffInitial: 1000, BackoffMax: 60000, Jitter: 0.5},
+					}).
+					Return(taskInfo, nil)
+			},
+		},
+		{
+			name:           "POST /tasks with timeout",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           TaskRequest{Payload: "Test task", Priority: 2, Timeout: 1500},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   taskInfoBody,
+			setupMock: func() {
+				mockQueue.AddTaskMock.
+					Expect(minimock.AnyContext, "", "Test task", 2, time.Time{}, queue.TaskOptions{Timeout: 1500 * time.Millisecond}).
+					Return(taskInfo, nil)
+			},
+		},
+		{
+			name:           "POST /tasks to named queue",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           TaskRequest{Payload: "Test task", Priority: 2, Queue: "email"},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   taskInfoBody,
+			setupMock: func() {
+				mockQueue.AddTaskMock.
+					Expect(minimock.AnyContext, "", "Test task", 2, time.Time{}, queue.TaskOptions{Queue: "email"}).
+					Return(taskInfo, nil)
+			},
+		},
+		{
+			name:           "POST /tasks with fixed retry schedule",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           `{"payload":"Test task","priority":2,"retry":{"type":"fixed","delays":[60000,300000,900000]}}`,
+			expectedStatus: http.StatusCreated,
+			expectedBody:   taskInfoBody,
+			setupMock: func() {
+				mockQueue.AddTaskMock.
+					Expect(minimock.AnyContext, "", "Test task", 2, time.Time{}, queue.TaskOptions{
+						Retry: &queue.RetryPolicy{Type: queue.RetryFixed, Delays: []int{60000, 300000, 900000}},
+					}).
+					Return(taskInfo, nil)
+			},
+		},
+		{
+			name:           "Repeated POST /tasks with Idempotency-Key header",
+			method:         http.MethodPost,
+			path:           "/tasks",
+			body:           TaskRequest{Payload: "Test task", Priority: 2},
+			headers:        map[string]string{"Idempotency-Key": "key-1"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"id\":\"task-1\",\"type\":\"default\",\"shard\":2,\"state\":\"queued\",\"priority\":2,\"attempts\":0,\"duplicate\":true}\n",
+			setupMock: func() {
+				mockQueue.AddTaskMock.
+					Expect(minimock.AnyContext, "", "Test task", 2, time.Time{}, queue.TaskOptions{IdempotencyKey: "key-1"}).
+					Return(&queue.TaskInfo{
+						ID:        "task-1",
+						Type:      "default",
+						Shard:     2,
+						State:     queue.TaskStateQueued,
+						Priority:  2,
+						Duplicate: true,
+					}, nil)
+			},
+		},
+		{
+			name:   "Successful POST /tasks/batch",
+			method: http.MethodPost,
+			path:   "/tasks/batch",
+			body: []TaskRequest{
+				{Payload: "First task", Priority: 2},
+				{Payload: "", Priority: 2},
+				{Type: "report", Payload: "Second task", Priority: 3, UniqueKey: "report:7"},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: "{\"added\":1,\"duplicates\":1,\"failed\":1,\"items\":[" +
+				"{\"index\":0,\"status\":201,\"id\":\"task-1\"}," +
+				"{\"index\":1,\"status\":400,\"error\":\"Payload is required\"}," +
+				"{\"index\":2,\"status\":200,\"id\":\"task-2\"}]}\n",
+			setupMock: func() {
+				mockQueue.AddTasksMock.
+					Expect(minimock.AnyContext, []queue.TaskSpec{
+						{Payload: "First task", Priority: 2},
+						{Type: "report", Payload: "Second task", Priority: 3, Options: queue.TaskOptions{UniqueKey: "report:7"}},
+					}).
+					Return([]queue.AddTaskResult{
+						{Info: &queue.TaskInfo{ID: "task-1"}},
+						{Info: &queue.TaskInfo{ID: "task-2", Duplicate: true}},
+					}, nil)
+			},
+		},
+		{
+			name:   "POST /tasks/batch with NDJSON",
+			method: http.MethodPost,
+			path:   "/tasks/batch",
+			body: "{\"payload\":\"First task\",\"priority\":2}\n" +
+				"\n" +
+				"not json\n" +
+				"{\"payload\":\"Second task\",\"priority\":9}\n" +
+				"{\"payload\":\"Third task\",\"priority\":1}\n",
+			expectedStatus: http.StatusOK,
+			expectedBody: "{\"added\":1,\"duplicates\":0,\"failed\":3,\"items\":[" +
+				"{\"index\":0,\"status\":201,\"id\":\"task-1\"}," +
+				"{\"index\":1,\"status\":400,\"error\":\"Invalid request body\"}," +
+				"{\"index\":2,\"status\":400,\"error\":\"Invalid priority\"}," +
+				"{\"index\":3,\"status\":500,\"error\":\"Failed to add task\"}]}\n",
+			setupMock: func() {
+				mockQueue.AddTasksMock.
+					Expect(minimock.AnyContext, []queue.TaskSpec{
+						{Payload: "First task", Priority: 2},
+						{Payload: "Third task", Priority: 1},
+					}).
+					Return([]queue.AddTaskResult{
+						{Info: &queue.TaskInfo{ID: "task-1"}},
+						{Err: errors.New("redis is down")},
+					}, nil)
+			},
+		},
+		{
+			name:           "Empty batch",
+			method:         http.MethodPost,
+			path:           "/tasks/batch",
+			body:           []TaskRequest{},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Batch is empty\n",
+			setupMock:      func() {},
+		},
+		{
+			name:           "Invalid batch body",
+			method:         http.MethodPost,
+			path:           "/tasks/batch",
+			body:           "[{\"payload\":",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Invalid request body\n",
+			setupMock:      func() {},
+		},
+		{
+			name:   "Batch with too many tasks",
+			method: http.MethodPost,
+			path:   "/tasks/batch",
+			body: []TaskRequest{
+				{Payload: "1", Priority: 2}, {Payload: "2", Priority: 2}, {Payload: "3", Priority: 2},
+				{Payload: "4", Priority: 2}, {Payload: "5", Priority: 2},
 			},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedBody:   "Batch is too large\n",
+			setupMock:      func() {},
+		},
+		{
+			name:           "NDJSON batch with too many tasks",
+			method:         http.MethodPost,
+			path:           "/tasks/batch",
+			body:           strings.Repeat("{\"payload\":\"task\",\"priority\":2}\n", 5),
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedBody:   "Batch is too large\n",
+			setupMock:      func() {},
+		},
+		{
+			name:           "Batch body is too large",
+			method:         http.MethodPost,
+			path:           "/tasks/batch",
+			body:           []TaskRequest{{Payload: strings.Repeat("x", 2048), Priority: 2}},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedBody:   "Batch is too large\n",
+			setupMock:      func() {},
+		},
+		{
+			name:           "AddTasks error",
+			method:         http.MethodPost,
+			path:           "/tasks/batch",
+			body:           []TaskRequest{{Payload: "First task", Priority: 2}},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "Failed to add tasks\n",
+			setupMock: func() {
+				mockQueue.AddTasksMock.
+					Expect(minimock.AnyContext, []queue.TaskSpec{{Payload: "First task", Priority: 2}}).
+					Return(nil, errors.New("redis is down"))
+			},
+		},
+		{
+			name:           "Successful GET /tasks/{id}",
+			method:         http.MethodGet,
+			path:           "/tasks/task-1",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   taskInfoBody,
+			setupMock: func() {
+				mockQueue.GetTaskMock.Expect(minimock.AnyContext, "task-1").Return(taskInfo, nil)
+			},
+		},
+		{
+			name:           "Task not found",
+			method:         http.MethodGet,
+			path:           "/tasks/missing",
+			body:           nil,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "Task not found\n",
+			setupMock: func() {
+				mockQueue.GetTaskMock.Expect(minimock.AnyContext, "missing").Return(nil, queue.ErrTaskNotFound)
+			},
+		},
+		{
+			name:           "GetTask error",
+			method:         http.MethodGet,
+			path:           "/tasks/task-1",
+			body:           nil,
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "Failed to get task\n",
+			setupMock: func() {
+				mockQueue.GetTaskMock.Expect(minimock.AnyContext, "task-1").Return(nil, errors.New("redis is down"))
+			},
+		},
+		{
+			name:           "Successful GET /tasks/{id}/result",
+			method:         http.MethodGet,
+			path:           "/tasks/task-1/result",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"id\":\"task-1\",\"result\":\"done\"}\n",
+			setupMock: func() {
+				mockQueue.GetResultMock.Expect(minimock.AnyContext, "task-1").Return(&queue.TaskResult{ID: "task-1", Result: "done"}, nil)
+			},
+		},
+		{
+			name:           "Result not ready",
+			method:         http.MethodGet,
+			path:           "/tasks/task-1/result",
+			body:           nil,
+			expectedStatus: http.StatusAccepted,
+			expectedBody:   "Task result is not ready\n",
+			setupMock: func() {
+				mockQueue.GetResultMock.Expect(minimock.AnyContext, "task-1").Return(nil, queue.ErrResultNotReady)
+			},
+		},
+		{
+			name:           "Result of failed task",
+			method:         http.MethodGet,
+			path:           "/tasks/task-1/result",
+			body:           nil,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "Task failed\n",
+			setupMock: func() {
+				mockQueue.GetResultMock.Expect(minimock.AnyContext, "task-1").Return(nil, queue.ErrTaskFailed)
+			},
+		},
+		{
+			name:           "Result of cancelled task",
+			method:         http.MethodGet,
+			path:           "/tasks/task-1/result",
+			body:           nil,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "Task was cancelled\n",
+			setupMock: func() {
+				mockQueue.GetResultMock.Expect(minimock.AnyContext, "task-1").Return(nil, queue.ErrTaskCancelled)
+			},
+		},
+		{
+			name:           "Result expired",
+			method:         http.MethodGet,
+			path:           "/tasks/task-1/result",
+			body:           nil,
+			expectedStatus: http.StatusGone,
+			expectedBody:   "Task result expired\n",
+			setupMock: func() {
+				mockQueue.GetResultMock.Expect(minimock.AnyContext, "task-1").Return(nil, queue.ErrResultExpired)
+			},
+		},
+		{
+			name:           "Result is not stored",
+			method:         http.MethodGet,
+			path:           "/tasks/task-1/result",
+			body:           nil,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "Task result is not stored\n",
+			setupMock: func() {
+				mockQueue.GetResultMock.Expect(minimock.AnyContext, "task-1").Return(nil, queue.ErrResultNotStored)
+			},
+		},
+		{
+			name:           "Result of unknown task",
+			method:         http.MethodGet,
+			path:           "/tasks/task-1/result",
+			body:           nil,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "Task not found\n",
+			setupMock: func() {
+				mockQueue.GetResultMock.Expect(minimock.AnyContext, "task-1").Return(nil, queue.ErrTaskNotFound)
+			},
+		},
+		{
+			name:           "GetResult error",
+			method:         http.MethodGet,
+			path:           "/tasks/task-1/result",
+			body:           nil,
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "Failed to get task result\n",
+			setupMock: func() {
+				mockQueue.GetResultMock.Expect(minimock.AnyContext, "task-1").Return(nil, errors.New("redis is down"))
+			},
+		},
+		{
+			name:           "Successful DELETE /tasks/{id}",
+			method:         http.MethodDelete,
+			path:           "/tasks/task-1",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"id\":\"task-1\",\"type\":\"default\",\"shard\":2,\"state\":\"cancelled\",\"priority\":2,\"attempts\":0}\n",
+			setupMock: func() {
+				mockQueue.CancelTaskMock.Expect(minimock.AnyContext, "task-1").Return(&queue.TaskInfo{
+					ID:       "task-1",
+					Type:     "default",
+					Shard:    2,
+					State:    queue.TaskStateCancelled,
+					Priority: 2,
+				}, nil)
+			},
+		},
+		{
+			name:           "Cancel unknown task",
+			method:         http.MethodDelete,
+			path:           "/tasks/missing",
+			body:           nil,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "Task not found\n",
+			setupMock: func() {
+				mockQueue.CancelTaskMock.Expect(minimock.AnyContext, "missing").Return(nil, queue.ErrTaskNotFound)
+			},
+		},
+		{
+			name:           "Cancel running task",
+			method:         http.MethodDelete,
+			path:           "/tasks/task-1",
+			body:           nil,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "Task is already running\n",
+			setupMock: func() {
+				mockQueue.CancelTaskMock.Expect(minimock.AnyContext, "task-1").Return(nil, queue.ErrTaskInFlight)
+			},
+		},
+		{
+			name:           "Cancel dead-lettered task",
+			method:         http.MethodDelete,
+			path:           "/tasks/task-1",
+			body:           nil,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "Task is in dead letter queue\n",
+			setupMock: func() {
+				mockQueue.CancelTaskMock.Expect(minimock.AnyContext, "task-1").Return(nil, queue.ErrTaskDead)
+			},
+		},
+		{
+			name:           "Cancel finished task",
+			method:         http.MethodDelete,
+			path:           "/tasks/task-1",
+			body:           nil,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "Task is already finished\n",
+			setupMock: func() {
+				mockQueue.CancelTaskMock.Expect(minimock.AnyContext, "task-1").Return(nil, queue.ErrTaskFinished)
+			},
+		},
+		{
+			name:           "CancelTask error",
+			method:         http.MethodDelete,
+			path:           "/tasks/task-1",
+			body:           nil,
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "Failed to cancel task\n",
+			setupMock: func() {
+				mockQueue.CancelTaskMock.Expect(minimock.AnyContext, "task-1").Return(nil, errors.New("redis is down"))
+			},
+		},
+		{
+			name:           "Successful GET /admin/dlq",
+			method:         http.MethodGet,
+			path:           "/admin/dlq?offset=10&limit=1",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"total\":11,\"offset\":10,\"limit\":1,\"items\":[" + deadTaskBody[:len(deadTaskBody)-1] + "]}\n",
+			setupMock: func() {
+				mockQueue.ListDeadTasksMock.Expect(minimock.AnyContext, 10, 1).Return(&queue.DeadTaskPage{Total: 11, Offset: 10, Limit: 1, Items: []*queue.DeadTask{deadTask}}, nil)
+			},
+		},
+		{
+			name:           "Invalid dead letter pagination",
+			method:         http.MethodGet,
+			path:           "/admin/dlq?limit=0",
+			body:           nil,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "Invalid pagination\n",
+			setupMock:      func() {},
+		},
+		{
+			name:           "Successful GET /admin/dlq/{id}",
+			method:         http.MethodGet,
+			path:           "/admin/dlq/task-2",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   deadTaskBody,
+			setupMock: func() {
+				mockQueue.GetDeadTaskMock.Expect(minimock.AnyContext, "task-2").Return(deadTask, nil)
+			},
+		},
+		{
+			name:           "Task not in dead letter queue",
+			method:         http.MethodGet,
+			path:           "/admin/dlq/task-1",
+			body:           nil,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "Task not found in dead letter queue\n",
+			setupMock: func() {
+				mockQueue.GetDeadTaskMock.Expect(minimock.AnyContext, "task-1").Return(nil, queue.ErrTaskNotFound)
+			},
+		},
+		{
+			name:           "Successful POST /admin/dlq/{id}/requeue",
+			method:         http.MethodPost,
+			path:           "/admin/dlq/task-2/requeue",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"id\":\"task-2\",\"type\":\"default\",\"shard\":1,\"state\":\"queued\",\"priority\":1,\"attempts\":0}\n",
+			setupMock: func() {
+				mockQueue.RequeueDeadTaskMock.Expect(minimock.AnyContext, "task-2").Return(&queue.TaskInfo{ID: "task-2", Type: "default", Shard: 1, State: queue.TaskStateQueued, Priority: 1}, nil)
+			},
+		},
+		{
+			name:           "Requeue corrupt dead task",
+			method:         http.MethodPost,
+			path:           "/admin/dlq/task-3/requeue",
+			body:           nil,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "Task record is corrupt\n",
+			setupMock: func() {
+				mockQueue.RequeueDeadTaskMock.Expect(minimock.AnyContext, "task-3").Return(nil, queue.ErrTaskCorrupt)
+			},
+		},
+		{
+			name:           "Requeue dead task with taken unique key",
+			method:         http.MethodPost,
+			path:           "/admin/dlq/task-4/requeue",
+			body:           nil,
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "Task with the same unique key is already active\n",
+			setupMock: func() {
+				mockQueue.RequeueDeadTaskMock.Expect(minimock.AnyContext, "task-4").Return(nil, queue.ErrUniqueKeyTaken)
+			},
+		},
+		{
+			name:           "Successful POST /admin/dlq/requeue",
+			method:         http.MethodPost,
+			path:           "/admin/dlq/requeue",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"requeued\":3}\n",
+			setupMock: func() {
+				mockQueue.RequeueDeadTasksMock.Return(3, nil)
+			},
+		},
+		{
+			name:           "Successful DELETE /admin/dlq/{id}",
+			method:         http.MethodDelete,
+			path:           "/admin/dlq/task-2",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"purged\":1}\n",
+			setupMock: func() {
+				mockQueue.PurgeDeadTaskMock.Expect(minimock.AnyContext, "task-2").Return(nil)
+			},
+		},
+		{
+			name:           "Purge task not in dead letter queue",
+			method:         http.MethodDelete,
+			path:           "/admin/dlq/task-1",
+			body:           nil,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "Task not found in dead letter queue\n",
+			setupMock: func() {
+				mockQueue.PurgeDeadTaskMock.Expect(minimock.AnyContext, "task-1").Return(queue.ErrTaskNotFound)
+			},
+		},
+		{
+			name:           "Successful DELETE /admin/dlq",
+			method:         http.MethodDelete,
+			path:           "/admin/dlq",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"purged\":2}\n",
+			setupMock: func() {
+				mockQueue.PurgeDeadTasksMock.Return(2, nil)
+			},
+		},
+		{
+			name:           "PurgeDeadTasks error",
+			method:         http.MethodDelete,
+			path:           "/admin/dlq",
+			body:           nil,
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "Failed to purge dead tasks\n",
+			setupMock: func() {
+				mockQueue.PurgeDeadTasksMock.Return(0, errors.New("redis is down"))
+			},
+		},
+		{
+			name:           "Successful GET /admin/queues",
+			method:         http.MethodGet,
+			path:           "/admin/queues",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "[" + emailStatusBody[:len(emailStatusBody)-1] + "]\n",
+			setupMock: func() {
+				mockQueue.ListQueuesMock.Return([]*queue.QueueStatus{emailStatus}, nil)
+			},
+		},
+		{
+			name:           "Successful POST /admin/queues/{name}/pause",
+			method:         http.MethodPost,
+			path:           "/admin/queues/email/pause",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   emailStatusBody,
+			setupMock: func() {
+				mockQueue.PauseQueueMock.Expect(minimock.AnyContext, "email", queue.AllShards).Return(emailStatus, nil)
+			},
+		},
+		{
+			name:           "Successful POST /admin/queues/{name}/shards/{n}/resume",
+			method:         http.MethodPost,
+			path:           "/admin/queues/email/shards/1/resume",
+			body:           nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   emailStatusBody,
+			setupMock: func() {
+				mockQueue.ResumeQueueMock.Expect(minimock.AnyContext, "email", 1).Return(emailStatus, nil)
+			},
+		},
+		{
+			name:           "Pause unknown queue",
+			method:         http.MethodPost,
+			path:           "/admin/queues/billing/pause",
+			body:           nil,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "Queue not found\n",
+			setupMock: func() {
+				mockQueue.PauseQueueMock.Expect(minimock.AnyContext, "billing", queue.AllShards).Return(nil, queue.ErrUnknownQueue)
+			},
+		},
+		{
+			name:           "Pause unknown shard",
+			method:         http.MethodPost,
+			path:           "/admin/queues/email/shards/5/pause",
+			body:           nil,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "Shard not found\n",
+			setupMock: func() {
+				mockQueue.PauseQueueMock.Expect(minimock.AnyContext, "email", 5).Return(nil, queue.ErrShardNotFound)
+			},
+		},
+		{
+			name:           "Invalid shard in pause path",
+			method:         http.MethodPost,
+			path:           "/admin/queues/email/shards/x/pause",
+			body:           nil,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "Not found\n",
+			setupMock:      func() {},
 		},
 		{
 			name:           "Unsupported path",
@@ -126,8 +825,9 @@ func TestHandler_ServeHTTP(t *testing.T) {
 			var body []byte
 			if tt.body != nil {
 				body, _ = json.Marshal(tt.body)
-				if tt.name == "Invalid JSON body" {
-					body = []byte(tt.body.(string))
+				// Строка отправляется как есть: так проверяются невалидный JSON и NDJSON
+				if raw, ok := tt.body.(string); ok {
+					body = []byte(raw)
 				}
 			}
 
@@ -136,6 +836,9 @@ func TestHandler_ServeHTTP(t *testing.T) {
 				t.Fatalf("Failed to create request: %v", err)
 			}
 			req.Header.Set("Content-Type", "application/json")
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
 			rr := httptest.NewRecorder()
 			handler.ServeHTTP(rr, req)
 
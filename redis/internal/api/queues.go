@@ -0,0 +1,101 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"task-queue/internal/queue"
+
+	"go.uber.org/zap"
+)
+
+// listQueues обрабатывает GET /admin/queues
+func (h *Handler) listQueues(w http.ResponseWriter, r *http.Request) {
+	statuses, err := h.queue.ListQueues(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to list queues",
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Failed to list queues", http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, statuses)
+}
+
+// pauseQueue обрабатывает POST /admin/queues/{name}/{pause|resume}
+// и POST /admin/queues/{name}/shards/{n}/{pause|resume}
+func (h *Handler) pauseQueue(w http.ResponseWriter, r *http.Request, name string, shard int, pause bool) {
+	var status *queue.QueueStatus
+	var err error
+	if pause {
+		status, err = h.queue.PauseQueue(r.Context(), name, shard)
+	} else {
+		status, err = h.queue.ResumeQueue(r.Context(), name, shard)
+	}
+	switch {
+	case errors.Is(err, queue.ErrUnknownQueue):
+		h.logger.Warn("Queue not found",
+			zap.String("queue", name),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Queue not found", http.StatusNotFound)
+		return
+	case errors.Is(err, queue.ErrShardNotFound):
+		h.logger.Warn("Shard not found",
+			zap.String("queue", name),
+			zap.Int("shard", shard),
+			zap.String("remote_addr", r.RemoteAddr))
+		http.Error(w, "Shard not found", http.StatusNotFound)
+		return
+	case err != nil:
+		h.logger.Error("Failed to change queue pause",
+			zap.String("queue", name),
+			zap.Int("shard", shard),
+			zap.Bool("pause", pause),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Error(err))
+		http.Error(w, "Failed to change queue pause", http.StatusInternalServerError)
+		return
+	}
+
+	h.logger.Info("Queue pause request processed",
+		zap.String("queue", name),
+		zap.Int("shard", shard),
+		zap.Bool("pause", pause),
+		zap.String("remote_addr", r.RemoteAddr))
+
+	writeJSON(w, http.StatusOK, status)
+}
+
+// queuePauseFromPath разбирает путь /admin/queues/{name}/{action} или
+// /admin/queues/{name}/shards/{n}/{action}, где action — pause или resume.
+// Для всей очереди возвращает queue.AllShards
+func queuePauseFromPath(path string) (name string, shard int, pause bool, ok bool) {
+	rest, ok := strings.CutPrefix(path, "/admin/queues/")
+	if !ok {
+		return "", 0, false, false
+	}
+
+	parts := strings.Split(rest, "/")
+	switch parts[len(parts)-1] {
+	case "pause":
+		pause = true
+	case "resume":
+	default:
+		return "", 0, false, false
+	}
+
+	switch {
+	case len(parts) == 2 && parts[0] != "":
+		return parts[0], queue.AllShards, pause, true
+	case len(parts) == 4 && parts[0] != "" && parts[1] == "shards":
+		n, err := strconv.Atoi(parts[2])
+		if err != nil || n < 0 {
+			return "", 0, false, false
+		}
+		return parts[0], n, pause, true
+	}
+	return "", 0, false, false
+}
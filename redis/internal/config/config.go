@@ -2,19 +2,25 @@ package config
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/viper"
 )
 
 // Config содержит настройки приложения
 type Config struct {
-	Redis      RedisConfig      `mapstructure:"redis"`
-	HTTP       HTTPConfig       `mapstructure:"http"`
-	Queues     QueuesConfig     `mapstructure:"queues"`
-	Metrics    MetricsConfig    `mapstructure:"metrics"`
-	Priorities PrioritiesConfig `mapstructure:"priorities"`
-	Retry      RetryConfig      `mapstructure:"retry"`
-	Logging    LoggingConfig    `mapstructure:"logging"`
+	Redis      RedisConfig               `mapstructure:"redis"`
+	HTTP       HTTPConfig                `mapstructure:"http"`
+	Queues     QueuesConfig              `mapstructure:"queues"`
+	Metrics    MetricsConfig             `mapstructure:"metrics"`
+	Priorities PrioritiesConfig          `mapstructure:"priorities"`
+	Scheduling SchedulingConfig          `mapstructure:"scheduling"`
+	Retry      RetryConfig               `mapstructure:"retry"`
+	Logging    LoggingConfig             `mapstructure:"logging"`
+	TaskTypes  map[string]TaskTypeConfig `mapstructure:"task_types"` // Настройки по типу задачи
+	// Именованные очереди со своими ключами, шардами и воркерами; задачи без очереди
+	// попадают в очередь default с ключами и настройками из queues
+	NamedQueues map[string]QueueConfig `mapstructure:"named_queues"`
 }
 
 // RedisConfig настройки Redis
@@ -24,15 +30,45 @@ type RedisConfig struct {
 
 // HTTPConfig настройки HTTP-сервера
 type HTTPConfig struct {
-	Port string `mapstructure:"port"`
+	Port          string `mapstructure:"port"`
+	MaxBatchSize  int    `mapstructure:"max_batch_size"`  // Сколько задач можно добавить одним запросом POST /tasks/batch
+	MaxBatchBytes int64  `mapstructure:"max_batch_bytes"` // Сколько байт может занимать тело POST /tasks/batch (по умолчанию 32 МиБ)
 }
 
 // QueuesConfig ключи очередей
 type QueuesConfig struct {
-	PriorityKey   string `mapstructure:"priority_key"`
-	DelayedKey    string `mapstructure:"delayed_key"`
-	ProcessingKey string `mapstructure:"processing_key"`
-	Shards        int    `mapstructure:"shards"`
+	PriorityKey       string `mapstructure:"priority_key"`
+	DelayedKey        string `mapstructure:"delayed_key"`
+	LeaseKey          string `mapstructure:"lease_key"` // Ключ задач в обработке (по умолчанию processing_leases)
+	NotifyKey         string `mapstructure:"notify_key"`
+	TaskKey           string `mapstructure:"task_key"`
+	IdempotencyKey    string `mapstructure:"idempotency_key"`
+	UniqueKey         string `mapstructure:"unique_key"`
+	DeadLetterKey     string `mapstructure:"dead_letter_key"`
+	PausedKey         string `mapstructure:"paused_key"`     // Хэш приостановленных очередей и шардов
+	RateLimitKey      string `mapstructure:"rate_limit_key"` // Префикс ключей ограничений частоты
+	Shards            int    `mapstructure:"shards"`
+	Workers           int    `mapstructure:"workers"`            // Сколько задач шарда выполняется одновременно (по умолчанию 1)
+	Concurrency       int    `mapstructure:"concurrency"`        // Сколько задач всех шардов выполняется одновременно (0 — без ограничения)
+	BlockTimeout      int    `mapstructure:"block_timeout"`      // Сколько воркер ждёт новую задачу, мс (не меньше секунды, по умолчанию секунда)
+	VisibilityTimeout int    `mapstructure:"visibility_timeout"` // Длительность аренды взятой задачи, мс (по умолчанию 30 секунд)
+	ReapInterval      int    `mapstructure:"reap_interval"`      // Период проверки истёкших аренд, мс (по умолчанию 5 секунд)
+	Retention         int    `mapstructure:"retention"`          // Сколько хранить записи выполненных и отменённых задач, мс (0 — удалять сразу; выполненную с результатом — не меньше 2 × result_ttl)
+	ResultTTL         int    `mapstructure:"result_ttl"`         // Сколько хранить результат выполненной задачи, мс (0 — не сохранять)
+	IdempotencyTTL    int    `mapstructure:"idempotency_ttl"`    // Сколько помнить ключи идемпотентности, мс (по умолчанию сутки)
+	HistoryLimit      int    `mapstructure:"history_limit"`      // Сколько последних попыток хранить в записи задачи (0 — не хранить)
+	TaskTimeout       int    `mapstructure:"task_timeout"`       // Сколько может выполняться задача, если не задано для неё или её типа, мс (0 — без ограничения)
+	// Очереди, задачи которых выполняет этот процесс (пусто — все). Добавлять задачи можно в любую очередь
+	Subscribe []string `mapstructure:"subscribe"`
+}
+
+// QueueConfig настройки именованной очереди
+type QueueConfig struct {
+	Prefix    string          `mapstructure:"prefix"`     // Префикс ключей Redis очереди (по умолчанию — имя очереди)
+	Shards    int             `mapstructure:"shards"`     // 0 — как queues.shards
+	Workers   int             `mapstructure:"workers"`    // Сколько задач шарда выполняется одновременно (0 — как queues.workers)
+	Retry     RetryConfig     `mapstructure:"retry"`      // Незаданные поля берутся из retry
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"` // Ограничение частоты выполнения задач очереди на всех репликах
 }
 
 // MetricsConfig ключ метрик
@@ -47,11 +83,34 @@ type PrioritiesConfig struct {
 	High   int `mapstructure:"high"`
 }
 
+// SchedulingConfig порядок, в котором воркеры забирают задачи разных приоритетов
+type SchedulingConfig struct {
+	Mode          string `mapstructure:"mode"`           // strict (по умолчанию), weighted или aging
+	Weights       []int  `mapstructure:"weights"`        // Веса уровней для weighted от priorities.high вниз; остальным уровням — последний вес
+	AgingInterval int    `mapstructure:"aging_interval"` // За сколько ожидания приоритет задачи в aging растёт на единицу, мс
+}
+
 // RetryConfig настройки повторов
 type RetryConfig struct {
-	MaxAttempts    int `mapstructure:"max_attempts"`
-	BackoffInitial int `mapstructure:"backoff_initial"`
-	BackoffFactor  int `mapstructure:"backoff_factor"`
+	Type           string  `mapstructure:"type"` // Политика повторов: exponential (по умолчанию) или fixed
+	MaxAttempts    int     `mapstructure:"max_attempts"`
+	BackoffInitial int     `mapstructure:"backoff_initial"` // Задержка перед первым повтором, мс
+	BackoffFactor  float64 `mapstructure:"backoff_factor"`
+	BackoffMax     int     `mapstructure:"backoff_max"` // Наибольшая задержка повтора для exponential, мс (0 — без ограничения)
+	Jitter         float64 `mapstructure:"jitter"`      // Случайное отклонение задержки, доля от 0 до 1
+	Delays         []int   `mapstructure:"delays"`      // Задержки повторов по порядку для политики fixed, мс
+}
+
+// TaskTypeConfig настройки задач одного типа
+type TaskTypeConfig struct {
+	Timeout   int             `mapstructure:"timeout"`    // Сколько может выполняться задача этого типа, мс (0 — как в queues.task_timeout)
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"` // Ограничение частоты выполнения задач этого типа на всех репликах
+}
+
+// RateLimitConfig ограничение частоты: не больше Limit задач за Period
+type RateLimitConfig struct {
+	Limit  int `mapstructure:"limit"`  // 0 — без ограничения
+	Period int `mapstructure:"period"` // Окно ограничения, мс (по умолчанию секунда)
 }
 
 // LoggingConfig настройки логирования
@@ -66,6 +125,13 @@ func LoadConfig() (*Config, error) {
 	v.SetConfigName("config")
 	v.SetConfigType("yaml")
 	v.AddConfigPath(".")
+	v.SetDefault("http.max_batch_bytes", 32<<20)
+	// processing_queue прежних версий хранил List, поэтому задачи в обработке лежат под новым ключом
+	v.SetDefault("queues.lease_key", "processing_leases")
+	v.SetDefault("queues.block_timeout", 1000)
+	v.SetDefault("queues.visibility_timeout", 30000)
+	v.SetDefault("queues.reap_interval", 5000)
+	v.SetDefault("queues.idempotency_ttl", 86400000)
 
 	if err := v.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("failed to read config: %w", err)
@@ -76,5 +142,82 @@ func LoadConfig() (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	if cfg.HTTP.MaxBatchBytes <= 0 {
+		return nil, fmt.Errorf("http.max_batch_bytes must be positive")
+	}
+	// BLPOP ждёт не меньше секунды, а с 0 — бесконечно, и воркер не заметил бы остановку
+	if cfg.Queues.BlockTimeout < 1000 {
+		return nil, fmt.Errorf("queues.block_timeout must be at least 1000")
+	}
+	if cfg.Queues.VisibilityTimeout <= 0 {
+		return nil, fmt.Errorf("queues.visibility_timeout must be positive")
+	}
+	if cfg.Queues.ReapInterval <= 0 {
+		return nil, fmt.Errorf("queues.reap_interval must be positive")
+	}
+	if cfg.Queues.IdempotencyTTL <= 0 {
+		return nil, fmt.Errorf("queues.idempotency_ttl must be positive")
+	}
+
+	switch cfg.Retry.Type {
+	case "", "exponential":
+	case "fixed":
+		if len(cfg.Retry.Delays) == 0 {
+			return nil, fmt.Errorf("retry.delays is required for fixed retry policy")
+		}
+	default:
+		return nil, fmt.Errorf("unknown retry policy type %q", cfg.Retry.Type)
+	}
+
+	for name, queue := range cfg.NamedQueues {
+		if name == "" || strings.Contains(name, ":") {
+			return nil, fmt.Errorf("invalid queue name %q", name)
+		}
+		if queue.Shards < 0 || queue.Workers < 0 {
+			return nil, fmt.Errorf("invalid shards or workers for queue %q", name)
+		}
+		switch queue.Retry.Type {
+		case "", "exponential":
+		case "fixed":
+			if len(queue.Retry.Delays) == 0 && len(cfg.Retry.Delays) == 0 {
+				return nil, fmt.Errorf("retry.delays is required for fixed retry policy of queue %q", name)
+			}
+		default:
+			return nil, fmt.Errorf("unknown retry policy type %q for queue %q", queue.Retry.Type, name)
+		}
+		if queue.RateLimit.Limit < 0 || queue.RateLimit.Period < 0 {
+			return nil, fmt.Errorf("invalid rate_limit for queue %q", name)
+		}
+	}
+	for name, taskType := range cfg.TaskTypes {
+		if taskType.RateLimit.Limit < 0 || taskType.RateLimit.Period < 0 {
+			return nil, fmt.Errorf("invalid rate_limit for task type %q", name)
+		}
+	}
+	for _, name := range cfg.Queues.Subscribe {
+		if _, ok := cfg.NamedQueues[name]; !ok && name != "default" {
+			return nil, fmt.Errorf("queues.subscribe refers to unknown queue %q", name)
+		}
+	}
+
+	switch cfg.Scheduling.Mode {
+	case "", "strict":
+	case "weighted":
+		if len(cfg.Scheduling.Weights) == 0 {
+			return nil, fmt.Errorf("scheduling.weights is required for weighted scheduling")
+		}
+		for _, weight := range cfg.Scheduling.Weights {
+			if weight <= 0 {
+				return nil, fmt.Errorf("scheduling.weights must be positive")
+			}
+		}
+	case "aging":
+		if cfg.Scheduling.AgingInterval <= 0 {
+			return nil, fmt.Errorf("scheduling.aging_interval is required for aging scheduling")
+		}
+	default:
+		return nil, fmt.Errorf("unknown scheduling mode %q", cfg.Scheduling.Mode)
+	}
+
 	return &cfg, nil
 }
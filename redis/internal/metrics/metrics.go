@@ -2,7 +2,9 @@ package metrics
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"time"
 
 	"task-queue/internal/config"
 
@@ -32,12 +34,43 @@ func (m *Metrics) IncrementSuccess(ctx context.Context) {
 	m.logger.Debug("Incremented success metric")
 }
 
-// IncrementFailed увеличивает счётчик проваленных задач
+// IncrementFailed увеличивает счётчик неудачных попыток, после которых задача повторяется
+// или исчерпывает попытки
 func (m *Metrics) IncrementFailed(ctx context.Context) {
 	m.client.HIncrBy(ctx, m.metricsKey, "failed", 1)
 	m.logger.Debug("Incremented failed metric")
 }
 
+// IncrementPermanentFailed увеличивает счётчик задач, отправленных в dead_letter_queue без повторов
+func (m *Metrics) IncrementPermanentFailed(ctx context.Context) {
+	m.client.HIncrBy(ctx, m.metricsKey, "permanent_failed", 1)
+	m.logger.Debug("Incremented permanent_failed metric")
+}
+
+// IncrementRetryDeferred увеличивает счётчик задач, отложенных обработчиком без расхода попытки
+func (m *Metrics) IncrementRetryDeferred(ctx context.Context) {
+	m.client.HIncrBy(ctx, m.metricsKey, "retry_deferred", 1)
+	m.logger.Debug("Incremented retry_deferred metric")
+}
+
+// IncrementRateLimited увеличивает счётчик задач, отложенных из-за ограничения частоты, на count
+func (m *Metrics) IncrementRateLimited(ctx context.Context, count int64) {
+	m.client.HIncrBy(ctx, m.metricsKey, "rate_limited", count)
+	m.logger.Debug("Incremented rate_limited metric", zap.Int64("count", count))
+}
+
+// IncrementDequeued увеличивает счётчик взятых в работу задач приоритета priority
+// и суммарное время их ожидания в очереди, по которым видно среднее ожидание уровня
+func (m *Metrics) IncrementDequeued(ctx context.Context, priority int, waited time.Duration) {
+	pipe := m.client.Pipeline()
+	pipe.HIncrBy(ctx, m.metricsKey, fmt.Sprintf("dequeued_priority_%d", priority), 1)
+	pipe.HIncrBy(ctx, m.metricsKey, fmt.Sprintf("dequeue_wait_ms_priority_%d", priority), waited.Milliseconds())
+	pipe.Exec(ctx)
+	m.logger.Debug("Incremented dequeued metrics",
+		zap.Int("priority", priority),
+		zap.Duration("waited", waited))
+}
+
 // IncrementTotalProcessed увеличивает счётчик обработанных задач
 func (m *Metrics) IncrementTotalProcessed(ctx context.Context) {
 	m.client.HIncrBy(ctx, m.metricsKey, "total_processed", 1)
@@ -50,6 +83,23 @@ func (m *Metrics) IncrementDeadLetter(ctx context.Context) {
 	m.logger.Debug("Incremented dead_letter metric")
 }
 
+// DecrementDeadLetter уменьшает счётчик задач в dead_letter_queue на count
+// после их повтора или удаления, чтобы он совпадал с размером очереди
+func (m *Metrics) DecrementDeadLetter(ctx context.Context, count int64) {
+	if count <= 0 {
+		return
+	}
+	m.client.HIncrBy(ctx, m.metricsKey, "dead_letter", -count)
+	m.logger.Debug("Decremented dead_letter metric",
+		zap.Int64("count", count))
+}
+
+// IncrementCancelled увеличивает счётчик отменённых задач
+func (m *Metrics) IncrementCancelled(ctx context.Context) {
+	m.client.HIncrBy(ctx, m.metricsKey, "cancelled", 1)
+	m.logger.Debug("Incremented cancelled metric")
+}
+
 // GetMetrics возвращает текущие метрики
 func (m *Metrics) GetMetrics(ctx context.Context) (map[string]int64, error) {
 	metrics, err := m.client.HGetAll(ctx, m.metricsKey).Result()
@@ -12,6 +12,7 @@ import (
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	mm_queue "task-queue/internal/queue"
 )
 
 // ITaskQueueMock implements ITaskQueue
@@ -19,19 +20,110 @@ type ITaskQueueMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAddTask          func(ctx context.Context, payload string, priority int, executeAt time.Time) (err error)
+	funcAddTask          func(ctx context.Context, taskType string, payload string, priority int, executeAt time.Time, opts mm_queue.TaskOptions) (tp1 *mm_queue.TaskInfo, err error)
 	funcAddTaskOrigin    string
-	inspectFuncAddTask   func(ctx context.Context, payload string, priority int, executeAt time.Time)
+	inspectFuncAddTask   func(ctx context.Context, taskType string, payload string, priority int, executeAt time.Time, opts mm_queue.TaskOptions)
 	afterAddTaskCounter  uint64
 	beforeAddTaskCounter uint64
 	AddTaskMock          mITaskQueueMockAddTask
 
+	funcAddTasks          func(ctx context.Context, specs []mm_queue.TaskSpec) (aa1 []mm_queue.AddTaskResult, err error)
+	funcAddTasksOrigin    string
+	inspectFuncAddTasks   func(ctx context.Context, specs []mm_queue.TaskSpec)
+	afterAddTasksCounter  uint64
+	beforeAddTasksCounter uint64
+	AddTasksMock          mITaskQueueMockAddTasks
+
+	funcCancelTask          func(ctx context.Context, taskID string) (tp1 *mm_queue.TaskInfo, err error)
+	funcCancelTaskOrigin    string
+	inspectFuncCancelTask   func(ctx context.Context, taskID string)
+	afterCancelTaskCounter  uint64
+	beforeCancelTaskCounter uint64
+	CancelTaskMock          mITaskQueueMockCancelTask
+
+	funcGetDeadTask          func(ctx context.Context, taskID string) (dp1 *mm_queue.DeadTask, err error)
+	funcGetDeadTaskOrigin    string
+	inspectFuncGetDeadTask   func(ctx context.Context, taskID string)
+	afterGetDeadTaskCounter  uint64
+	beforeGetDeadTaskCounter uint64
+	GetDeadTaskMock          mITaskQueueMockGetDeadTask
+
+	funcGetResult          func(ctx context.Context, taskID string) (tp1 *mm_queue.TaskResult, err error)
+	funcGetResultOrigin    string
+	inspectFuncGetResult   func(ctx context.Context, taskID string)
+	afterGetResultCounter  uint64
+	beforeGetResultCounter uint64
+	GetResultMock          mITaskQueueMockGetResult
+
+	funcGetTask          func(ctx context.Context, taskID string) (tp1 *mm_queue.TaskInfo, err error)
+	funcGetTaskOrigin    string
+	inspectFuncGetTask   func(ctx context.Context, taskID string)
+	afterGetTaskCounter  uint64
+	beforeGetTaskCounter uint64
+	GetTaskMock          mITaskQueueMockGetTask
+
+	funcListDeadTasks          func(ctx context.Context, offset int, limit int) (dp1 *mm_queue.DeadTaskPage, err error)
+	funcListDeadTasksOrigin    string
+	inspectFuncListDeadTasks   func(ctx context.Context, offset int, limit int)
+	afterListDeadTasksCounter  uint64
+	beforeListDeadTasksCounter uint64
+	ListDeadTasksMock          mITaskQueueMockListDeadTasks
+
+	funcListQueues          func(ctx context.Context) (qpa1 []*mm_queue.QueueStatus, err error)
+	funcListQueuesOrigin    string
+	inspectFuncListQueues   func(ctx context.Context)
+	afterListQueuesCounter  uint64
+	beforeListQueuesCounter uint64
+	ListQueuesMock          mITaskQueueMockListQueues
+
+	funcPauseQueue          func(ctx context.Context, name string, shard int) (qp1 *mm_queue.QueueStatus, err error)
+	funcPauseQueueOrigin    string
+	inspectFuncPauseQueue   func(ctx context.Context, name string, shard int)
+	afterPauseQueueCounter  uint64
+	beforePauseQueueCounter uint64
+	PauseQueueMock          mITaskQueueMockPauseQueue
+
 	funcProcessTasks          func(ctx context.Context)
 	funcProcessTasksOrigin    string
 	inspectFuncProcessTasks   func(ctx context.Context)
 	afterProcessTasksCounter  uint64
 	beforeProcessTasksCounter uint64
 	ProcessTasksMock          mITaskQueueMockProcessTasks
+
+	funcPurgeDeadTask          func(ctx context.Context, taskID string) (err error)
+	funcPurgeDeadTaskOrigin    string
+	inspectFuncPurgeDeadTask   func(ctx context.Context, taskID string)
+	afterPurgeDeadTaskCounter  uint64
+	beforePurgeDeadTaskCounter uint64
+	PurgeDeadTaskMock          mITaskQueueMockPurgeDeadTask
+
+	funcPurgeDeadTasks          func(ctx context.Context) (i1 int64, err error)
+	funcPurgeDeadTasksOrigin    string
+	inspectFuncPurgeDeadTasks   func(ctx context.Context)
+	afterPurgeDeadTasksCounter  uint64
+	beforePurgeDeadTasksCounter uint64
+	PurgeDeadTasksMock          mITaskQueueMockPurgeDeadTasks
+
+	funcRequeueDeadTask          func(ctx context.Context, taskID string) (tp1 *mm_queue.TaskInfo, err error)
+	funcRequeueDeadTaskOrigin    string
+	inspectFuncRequeueDeadTask   func(ctx context.Context, taskID string)
+	afterRequeueDeadTaskCounter  uint64
+	beforeRequeueDeadTaskCounter uint64
+	RequeueDeadTaskMock          mITaskQueueMockRequeueDeadTask
+
+	funcRequeueDeadTasks          func(ctx context.Context) (i1 int64, err error)
+	funcRequeueDeadTasksOrigin    string
+	inspectFuncRequeueDeadTasks   func(ctx context.Context)
+	afterRequeueDeadTasksCounter  uint64
+	beforeRequeueDeadTasksCounter uint64
+	RequeueDeadTasksMock          mITaskQueueMockRequeueDeadTasks
+
+	funcResumeQueue          func(ctx context.Context, name string, shard int) (qp1 *mm_queue.QueueStatus, err error)
+	funcResumeQueueOrigin    string
+	inspectFuncResumeQueue   func(ctx context.Context, name string, shard int)
+	afterResumeQueueCounter  uint64
+	beforeResumeQueueCounter uint64
+	ResumeQueueMock          mITaskQueueMockResumeQueue
 }
 
 // NewITaskQueueMock returns a mock for ITaskQueue
@@ -45,9 +137,48 @@ func NewITaskQueueMock(t minimock.Tester) *ITaskQueueMock {
 	m.AddTaskMock = mITaskQueueMockAddTask{mock: m}
 	m.AddTaskMock.callArgs = []*ITaskQueueMockAddTaskParams{}
 
+	m.AddTasksMock = mITaskQueueMockAddTasks{mock: m}
+	m.AddTasksMock.callArgs = []*ITaskQueueMockAddTasksParams{}
+
+	m.CancelTaskMock = mITaskQueueMockCancelTask{mock: m}
+	m.CancelTaskMock.callArgs = []*ITaskQueueMockCancelTaskParams{}
+
+	m.GetDeadTaskMock = mITaskQueueMockGetDeadTask{mock: m}
+	m.GetDeadTaskMock.callArgs = []*ITaskQueueMockGetDeadTaskParams{}
+
+	m.GetResultMock = mITaskQueueMockGetResult{mock: m}
+	m.GetResultMock.callArgs = []*ITaskQueueMockGetResultParams{}
+
+	m.GetTaskMock = mITaskQueueMockGetTask{mock: m}
+	m.GetTaskMock.callArgs = []*ITaskQueueMockGetTaskParams{}
+
+	m.ListDeadTasksMock = mITaskQueueMockListDeadTasks{mock: m}
+	m.ListDeadTasksMock.callArgs = []*ITaskQueueMockListDeadTasksParams{}
+
+	m.ListQueuesMock = mITaskQueueMockListQueues{mock: m}
+	m.ListQueuesMock.callArgs = []*ITaskQueueMockListQueuesParams{}
+
+	m.PauseQueueMock = mITaskQueueMockPauseQueue{mock: m}
+	m.PauseQueueMock.callArgs = []*ITaskQueueMockPauseQueueParams{}
+
 	m.ProcessTasksMock = mITaskQueueMockProcessTasks{mock: m}
 	m.ProcessTasksMock.callArgs = []*ITaskQueueMockProcessTasksParams{}
 
+	m.PurgeDeadTaskMock = mITaskQueueMockPurgeDeadTask{mock: m}
+	m.PurgeDeadTaskMock.callArgs = []*ITaskQueueMockPurgeDeadTaskParams{}
+
+	m.PurgeDeadTasksMock = mITaskQueueMockPurgeDeadTasks{mock: m}
+	m.PurgeDeadTasksMock.callArgs = []*ITaskQueueMockPurgeDeadTasksParams{}
+
+	m.RequeueDeadTaskMock = mITaskQueueMockRequeueDeadTask{mock: m}
+	m.RequeueDeadTaskMock.callArgs = []*ITaskQueueMockRequeueDeadTaskParams{}
+
+	m.RequeueDeadTasksMock = mITaskQueueMockRequeueDeadTasks{mock: m}
+	m.RequeueDeadTasksMock.callArgs = []*ITaskQueueMockRequeueDeadTasksParams{}
+
+	m.ResumeQueueMock = mITaskQueueMockResumeQueue{mock: m}
+	m.ResumeQueueMock.callArgs = []*ITaskQueueMockResumeQueueParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -80,21 +211,26 @@ type ITaskQueueMockAddTaskExpectation struct {
 // ITaskQueueMockAddTaskParams contains parameters of the ITaskQueue.AddTask
 type ITaskQueueMockAddTaskParams struct {
 	ctx       context.Context
+	taskType  string
 	payload   string
 	priority  int
 	executeAt time.Time
+	opts      mm_queue.TaskOptions
 }
 
 // ITaskQueueMockAddTaskParamPtrs contains pointers to parameters of the ITaskQueue.AddTask
 type ITaskQueueMockAddTaskParamPtrs struct {
 	ctx       *context.Context
+	taskType  *string
 	payload   *string
 	priority  *int
 	executeAt *time.Time
+	opts      *mm_queue.TaskOptions
 }
 
 // ITaskQueueMockAddTaskResults contains results of the ITaskQueue.AddTask
 type ITaskQueueMockAddTaskResults struct {
+	tp1 *mm_queue.TaskInfo
 	err error
 }
 
@@ -102,9 +238,11 @@ type ITaskQueueMockAddTaskResults struct {
 type ITaskQueueMockAddTaskExpectationOrigins struct {
 	origin          string
 	originCtx       string
+	originTaskType  string
 	originPayload   string
 	originPriority  string
 	originExecuteAt string
+	originOpts      string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -118,7 +256,7 @@ func (mmAddTask *mITaskQueueMockAddTask) Optional() *mITaskQueueMockAddTask {
 }
 
 // Expect sets up expected params for ITaskQueue.AddTask
-func (mmAddTask *mITaskQueueMockAddTask) Expect(ctx context.Context, payload string, priority int, executeAt time.Time) *mITaskQueueMockAddTask {
+func (mmAddTask *mITaskQueueMockAddTask) Expect(ctx context.Context, taskType string, payload string, priority int, executeAt time.Time, opts mm_queue.TaskOptions) *mITaskQueueMockAddTask {
 	if mmAddTask.mock.funcAddTask != nil {
 		mmAddTask.mock.t.Fatalf("ITaskQueueMock.AddTask mock is already set by Set")
 	}
@@ -131,7 +269,7 @@ func (mmAddTask *mITaskQueueMockAddTask) Expect(ctx context.Context, payload str
 		mmAddTask.mock.t.Fatalf("ITaskQueueMock.AddTask mock is already set by ExpectParams functions")
 	}
 
-	mmAddTask.defaultExpectation.params = &ITaskQueueMockAddTaskParams{ctx, payload, priority, executeAt}
+	mmAddTask.defaultExpectation.params = &ITaskQueueMockAddTaskParams{ctx, taskType, payload, priority, executeAt, opts}
 	mmAddTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAddTask.expectations {
 		if minimock.Equal(e.params, mmAddTask.defaultExpectation.params) {
@@ -165,8 +303,31 @@ func (mmAddTask *mITaskQueueMockAddTask) ExpectCtxParam1(ctx context.Context) *m
 	return mmAddTask
 }
 
-// ExpectPayloadParam2 sets up expected param payload for ITaskQueue.AddTask
-func (mmAddTask *mITaskQueueMockAddTask) ExpectPayloadParam2(payload string) *mITaskQueueMockAddTask {
+// ExpectTaskTypeParam2 sets up expected param taskType for ITaskQueue.AddTask
+func (mmAddTask *mITaskQueueMockAddTask) ExpectTaskTypeParam2(taskType string) *mITaskQueueMockAddTask {
+	if mmAddTask.mock.funcAddTask != nil {
+		mmAddTask.mock.t.Fatalf("ITaskQueueMock.AddTask mock is already set by Set")
+	}
+
+	if mmAddTask.defaultExpectation == nil {
+		mmAddTask.defaultExpectation = &ITaskQueueMockAddTaskExpectation{}
+	}
+
+	if mmAddTask.defaultExpectation.params != nil {
+		mmAddTask.mock.t.Fatalf("ITaskQueueMock.AddTask mock is already set by Expect")
+	}
+
+	if mmAddTask.defaultExpectation.paramPtrs == nil {
+		mmAddTask.defaultExpectation.paramPtrs = &ITaskQueueMockAddTaskParamPtrs{}
+	}
+	mmAddTask.defaultExpectation.paramPtrs.taskType = &taskType
+	mmAddTask.defaultExpectation.expectationOrigins.originTaskType = minimock.CallerInfo(1)
+
+	return mmAddTask
+}
+
+// ExpectPayloadParam3 sets up expected param payload for ITaskQueue.AddTask
+func (mmAddTask *mITaskQueueMockAddTask) ExpectPayloadParam3(payload string) *mITaskQueueMockAddTask {
 	if mmAddTask.mock.funcAddTask != nil {
 		mmAddTask.mock.t.Fatalf("ITaskQueueMock.AddTask mock is already set by Set")
 	}
@@ -188,8 +349,8 @@ func (mmAddTask *mITaskQueueMockAddTask) ExpectPayloadParam2(payload string) *mI
 	return mmAddTask
 }
 
-// ExpectPriorityParam3 sets up expected param priority for ITaskQueue.AddTask
-func (mmAddTask *mITaskQueueMockAddTask) ExpectPriorityParam3(priority int) *mITaskQueueMockAddTask {
+// ExpectPriorityParam4 sets up expected param priority for ITaskQueue.AddTask
+func (mmAddTask *mITaskQueueMockAddTask) ExpectPriorityParam4(priority int) *mITaskQueueMockAddTask {
 	if mmAddTask.mock.funcAddTask != nil {
 		mmAddTask.mock.t.Fatalf("ITaskQueueMock.AddTask mock is already set by Set")
 	}
@@ -211,8 +372,8 @@ func (mmAddTask *mITaskQueueMockAddTask) ExpectPriorityParam3(priority int) *mIT
 	return mmAddTask
 }
 
-// ExpectExecuteAtParam4 sets up expected param executeAt for ITaskQueue.AddTask
-func (mmAddTask *mITaskQueueMockAddTask) ExpectExecuteAtParam4(executeAt time.Time) *mITaskQueueMockAddTask {
+// ExpectExecuteAtParam5 sets up expected param executeAt for ITaskQueue.AddTask
+func (mmAddTask *mITaskQueueMockAddTask) ExpectExecuteAtParam5(executeAt time.Time) *mITaskQueueMockAddTask {
 	if mmAddTask.mock.funcAddTask != nil {
 		mmAddTask.mock.t.Fatalf("ITaskQueueMock.AddTask mock is already set by Set")
 	}
@@ -234,8 +395,31 @@ func (mmAddTask *mITaskQueueMockAddTask) ExpectExecuteAtParam4(executeAt time.Ti
 	return mmAddTask
 }
 
+// ExpectOptsParam6 sets up expected param opts for ITaskQueue.AddTask
+func (mmAddTask *mITaskQueueMockAddTask) ExpectOptsParam6(opts mm_queue.TaskOptions) *mITaskQueueMockAddTask {
+	if mmAddTask.mock.funcAddTask != nil {
+		mmAddTask.mock.t.Fatalf("ITaskQueueMock.AddTask mock is already set by Set")
+	}
+
+	if mmAddTask.defaultExpectation == nil {
+		mmAddTask.defaultExpectation = &ITaskQueueMockAddTaskExpectation{}
+	}
+
+	if mmAddTask.defaultExpectation.params != nil {
+		mmAddTask.mock.t.Fatalf("ITaskQueueMock.AddTask mock is already set by Expect")
+	}
+
+	if mmAddTask.defaultExpectation.paramPtrs == nil {
+		mmAddTask.defaultExpectation.paramPtrs = &ITaskQueueMockAddTaskParamPtrs{}
+	}
+	mmAddTask.defaultExpectation.paramPtrs.opts = &opts
+	mmAddTask.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmAddTask
+}
+
 // Inspect accepts an inspector function that has same arguments as the ITaskQueue.AddTask
-func (mmAddTask *mITaskQueueMockAddTask) Inspect(f func(ctx context.Context, payload string, priority int, executeAt time.Time)) *mITaskQueueMockAddTask {
+func (mmAddTask *mITaskQueueMockAddTask) Inspect(f func(ctx context.Context, taskType string, payload string, priority int, executeAt time.Time, opts mm_queue.TaskOptions)) *mITaskQueueMockAddTask {
 	if mmAddTask.mock.inspectFuncAddTask != nil {
 		mmAddTask.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.AddTask")
 	}
@@ -246,7 +430,7 @@ func (mmAddTask *mITaskQueueMockAddTask) Inspect(f func(ctx context.Context, pay
 }
 
 // Return sets up results that will be returned by ITaskQueue.AddTask
-func (mmAddTask *mITaskQueueMockAddTask) Return(err error) *ITaskQueueMock {
+func (mmAddTask *mITaskQueueMockAddTask) Return(tp1 *mm_queue.TaskInfo, err error) *ITaskQueueMock {
 	if mmAddTask.mock.funcAddTask != nil {
 		mmAddTask.mock.t.Fatalf("ITaskQueueMock.AddTask mock is already set by Set")
 	}
@@ -254,13 +438,13 @@ func (mmAddTask *mITaskQueueMockAddTask) Return(err error) *ITaskQueueMock {
 	if mmAddTask.defaultExpectation == nil {
 		mmAddTask.defaultExpectation = &ITaskQueueMockAddTaskExpectation{mock: mmAddTask.mock}
 	}
-	mmAddTask.defaultExpectation.results = &ITaskQueueMockAddTaskResults{err}
+	mmAddTask.defaultExpectation.results = &ITaskQueueMockAddTaskResults{tp1, err}
 	mmAddTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmAddTask.mock
 }
 
 // Set uses given function f to mock the ITaskQueue.AddTask method
-func (mmAddTask *mITaskQueueMockAddTask) Set(f func(ctx context.Context, payload string, priority int, executeAt time.Time) (err error)) *ITaskQueueMock {
+func (mmAddTask *mITaskQueueMockAddTask) Set(f func(ctx context.Context, taskType string, payload string, priority int, executeAt time.Time, opts mm_queue.TaskOptions) (tp1 *mm_queue.TaskInfo, err error)) *ITaskQueueMock {
 	if mmAddTask.defaultExpectation != nil {
 		mmAddTask.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.AddTask method")
 	}
@@ -276,14 +460,14 @@ func (mmAddTask *mITaskQueueMockAddTask) Set(f func(ctx context.Context, payload
 
 // When sets expectation for the ITaskQueue.AddTask which will trigger the result defined by the following
 // Then helper
-func (mmAddTask *mITaskQueueMockAddTask) When(ctx context.Context, payload string, priority int, executeAt time.Time) *ITaskQueueMockAddTaskExpectation {
+func (mmAddTask *mITaskQueueMockAddTask) When(ctx context.Context, taskType string, payload string, priority int, executeAt time.Time, opts mm_queue.TaskOptions) *ITaskQueueMockAddTaskExpectation {
 	if mmAddTask.mock.funcAddTask != nil {
 		mmAddTask.mock.t.Fatalf("ITaskQueueMock.AddTask mock is already set by Set")
 	}
 
 	expectation := &ITaskQueueMockAddTaskExpectation{
 		mock:               mmAddTask.mock,
-		params:             &ITaskQueueMockAddTaskParams{ctx, payload, priority, executeAt},
+		params:             &ITaskQueueMockAddTaskParams{ctx, taskType, payload, priority, executeAt, opts},
 		expectationOrigins: ITaskQueueMockAddTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmAddTask.expectations = append(mmAddTask.expectations, expectation)
@@ -291,8 +475,8 @@ func (mmAddTask *mITaskQueueMockAddTask) When(ctx context.Context, payload strin
 }
 
 // Then sets up ITaskQueue.AddTask return parameters for the expectation previously defined by the When method
-func (e *ITaskQueueMockAddTaskExpectation) Then(err error) *ITaskQueueMock {
-	e.results = &ITaskQueueMockAddTaskResults{err}
+func (e *ITaskQueueMockAddTaskExpectation) Then(tp1 *mm_queue.TaskInfo, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockAddTaskResults{tp1, err}
 	return e.mock
 }
 
@@ -318,17 +502,17 @@ func (mmAddTask *mITaskQueueMockAddTask) invocationsDone() bool {
 }
 
 // AddTask implements ITaskQueue
-func (mmAddTask *ITaskQueueMock) AddTask(ctx context.Context, payload string, priority int, executeAt time.Time) (err error) {
+func (mmAddTask *ITaskQueueMock) AddTask(ctx context.Context, taskType string, payload string, priority int, executeAt time.Time, opts mm_queue.TaskOptions) (tp1 *mm_queue.TaskInfo, err error) {
 	mm_atomic.AddUint64(&mmAddTask.beforeAddTaskCounter, 1)
 	defer mm_atomic.AddUint64(&mmAddTask.afterAddTaskCounter, 1)
 
 	mmAddTask.t.Helper()
 
 	if mmAddTask.inspectFuncAddTask != nil {
-		mmAddTask.inspectFuncAddTask(ctx, payload, priority, executeAt)
+		mmAddTask.inspectFuncAddTask(ctx, taskType, payload, priority, executeAt, opts)
 	}
 
-	mm_params := ITaskQueueMockAddTaskParams{ctx, payload, priority, executeAt}
+	mm_params := ITaskQueueMockAddTaskParams{ctx, taskType, payload, priority, executeAt, opts}
 
 	// Record call args
 	mmAddTask.AddTaskMock.mutex.Lock()
@@ -338,7 +522,7 @@ func (mmAddTask *ITaskQueueMock) AddTask(ctx context.Context, payload string, pr
 	for _, e := range mmAddTask.AddTaskMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.tp1, e.results.err
 		}
 	}
 
@@ -347,7 +531,7 @@ func (mmAddTask *ITaskQueueMock) AddTask(ctx context.Context, payload string, pr
 		mm_want := mmAddTask.AddTaskMock.defaultExpectation.params
 		mm_want_ptrs := mmAddTask.AddTaskMock.defaultExpectation.paramPtrs
 
-		mm_got := ITaskQueueMockAddTaskParams{ctx, payload, priority, executeAt}
+		mm_got := ITaskQueueMockAddTaskParams{ctx, taskType, payload, priority, executeAt, opts}
 
 		if mm_want_ptrs != nil {
 
@@ -356,6 +540,11 @@ func (mmAddTask *ITaskQueueMock) AddTask(ctx context.Context, payload string, pr
 					mmAddTask.AddTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.taskType != nil && !minimock.Equal(*mm_want_ptrs.taskType, mm_got.taskType) {
+				mmAddTask.t.Errorf("ITaskQueueMock.AddTask got unexpected parameter taskType, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddTask.AddTaskMock.defaultExpectation.expectationOrigins.originTaskType, *mm_want_ptrs.taskType, mm_got.taskType, minimock.Diff(*mm_want_ptrs.taskType, mm_got.taskType))
+			}
+
 			if mm_want_ptrs.payload != nil && !minimock.Equal(*mm_want_ptrs.payload, mm_got.payload) {
 				mmAddTask.t.Errorf("ITaskQueueMock.AddTask got unexpected parameter payload, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmAddTask.AddTaskMock.defaultExpectation.expectationOrigins.originPayload, *mm_want_ptrs.payload, mm_got.payload, minimock.Diff(*mm_want_ptrs.payload, mm_got.payload))
@@ -371,6 +560,11 @@ func (mmAddTask *ITaskQueueMock) AddTask(ctx context.Context, payload string, pr
 					mmAddTask.AddTaskMock.defaultExpectation.expectationOrigins.originExecuteAt, *mm_want_ptrs.executeAt, mm_got.executeAt, minimock.Diff(*mm_want_ptrs.executeAt, mm_got.executeAt))
 			}
 
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmAddTask.t.Errorf("ITaskQueueMock.AddTask got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddTask.AddTaskMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmAddTask.t.Errorf("ITaskQueueMock.AddTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmAddTask.AddTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -380,12 +574,12 @@ func (mmAddTask *ITaskQueueMock) AddTask(ctx context.Context, payload string, pr
 		if mm_results == nil {
 			mmAddTask.t.Fatal("No results are set for the ITaskQueueMock.AddTask")
 		}
-		return (*mm_results).err
+		return (*mm_results).tp1, (*mm_results).err
 	}
 	if mmAddTask.funcAddTask != nil {
-		return mmAddTask.funcAddTask(ctx, payload, priority, executeAt)
+		return mmAddTask.funcAddTask(ctx, taskType, payload, priority, executeAt, opts)
 	}
-	mmAddTask.t.Fatalf("Unexpected call to ITaskQueueMock.AddTask. %v %v %v %v", ctx, payload, priority, executeAt)
+	mmAddTask.t.Fatalf("Unexpected call to ITaskQueueMock.AddTask. %v %v %v %v %v %v", ctx, taskType, payload, priority, executeAt, opts)
 	return
 }
 
@@ -457,44 +651,53 @@ func (m *ITaskQueueMock) MinimockAddTaskInspect() {
 	}
 }
 
-type mITaskQueueMockProcessTasks struct {
+type mITaskQueueMockAddTasks struct {
 	optional           bool
 	mock               *ITaskQueueMock
-	defaultExpectation *ITaskQueueMockProcessTasksExpectation
-	expectations       []*ITaskQueueMockProcessTasksExpectation
+	defaultExpectation *ITaskQueueMockAddTasksExpectation
+	expectations       []*ITaskQueueMockAddTasksExpectation
 
-	callArgs []*ITaskQueueMockProcessTasksParams
+	callArgs []*ITaskQueueMockAddTasksParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ITaskQueueMockProcessTasksExpectation specifies expectation struct of the ITaskQueue.ProcessTasks
-type ITaskQueueMockProcessTasksExpectation struct {
+// ITaskQueueMockAddTasksExpectation specifies expectation struct of the ITaskQueue.AddTasks
+type ITaskQueueMockAddTasksExpectation struct {
 	mock               *ITaskQueueMock
-	params             *ITaskQueueMockProcessTasksParams
-	paramPtrs          *ITaskQueueMockProcessTasksParamPtrs
-	expectationOrigins ITaskQueueMockProcessTasksExpectationOrigins
+	params             *ITaskQueueMockAddTasksParams
+	paramPtrs          *ITaskQueueMockAddTasksParamPtrs
+	expectationOrigins ITaskQueueMockAddTasksExpectationOrigins
+	results            *ITaskQueueMockAddTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
 
-	returnOrigin string
-	Counter      uint64
+// ITaskQueueMockAddTasksParams contains parameters of the ITaskQueue.AddTasks
+type ITaskQueueMockAddTasksParams struct {
+	ctx   context.Context
+	specs []mm_queue.TaskSpec
 }
 
-// ITaskQueueMockProcessTasksParams contains parameters of the ITaskQueue.ProcessTasks
-type ITaskQueueMockProcessTasksParams struct {
-	ctx context.Context
+// ITaskQueueMockAddTasksParamPtrs contains pointers to parameters of the ITaskQueue.AddTasks
+type ITaskQueueMockAddTasksParamPtrs struct {
+	ctx   *context.Context
+	specs *[]mm_queue.TaskSpec
 }
 
-// ITaskQueueMockProcessTasksParamPtrs contains pointers to parameters of the ITaskQueue.ProcessTasks
-type ITaskQueueMockProcessTasksParamPtrs struct {
-	ctx *context.Context
+// ITaskQueueMockAddTasksResults contains results of the ITaskQueue.AddTasks
+type ITaskQueueMockAddTasksResults struct {
+	aa1 []mm_queue.AddTaskResult
+	err error
 }
 
-// ITaskQueueMockProcessTasksOrigins contains origins of expectations of the ITaskQueue.ProcessTasks
-type ITaskQueueMockProcessTasksExpectationOrigins struct {
-	origin    string
-	originCtx string
+// ITaskQueueMockAddTasksOrigins contains origins of expectations of the ITaskQueue.AddTasks
+type ITaskQueueMockAddTasksExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSpecs string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -502,272 +705,4747 @@ type ITaskQueueMockProcessTasksExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmProcessTasks *mITaskQueueMockProcessTasks) Optional() *mITaskQueueMockProcessTasks {
-	mmProcessTasks.optional = true
-	return mmProcessTasks
+func (mmAddTasks *mITaskQueueMockAddTasks) Optional() *mITaskQueueMockAddTasks {
+	mmAddTasks.optional = true
+	return mmAddTasks
 }
 
-// Expect sets up expected params for ITaskQueue.ProcessTasks
-func (mmProcessTasks *mITaskQueueMockProcessTasks) Expect(ctx context.Context) *mITaskQueueMockProcessTasks {
-	if mmProcessTasks.mock.funcProcessTasks != nil {
-		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by Set")
+// Expect sets up expected params for ITaskQueue.AddTasks
+func (mmAddTasks *mITaskQueueMockAddTasks) Expect(ctx context.Context, specs []mm_queue.TaskSpec) *mITaskQueueMockAddTasks {
+	if mmAddTasks.mock.funcAddTasks != nil {
+		mmAddTasks.mock.t.Fatalf("ITaskQueueMock.AddTasks mock is already set by Set")
 	}
 
-	if mmProcessTasks.defaultExpectation == nil {
-		mmProcessTasks.defaultExpectation = &ITaskQueueMockProcessTasksExpectation{}
+	if mmAddTasks.defaultExpectation == nil {
+		mmAddTasks.defaultExpectation = &ITaskQueueMockAddTasksExpectation{}
 	}
 
-	if mmProcessTasks.defaultExpectation.paramPtrs != nil {
-		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by ExpectParams functions")
+	if mmAddTasks.defaultExpectation.paramPtrs != nil {
+		mmAddTasks.mock.t.Fatalf("ITaskQueueMock.AddTasks mock is already set by ExpectParams functions")
 	}
 
-	mmProcessTasks.defaultExpectation.params = &ITaskQueueMockProcessTasksParams{ctx}
-	mmProcessTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmProcessTasks.expectations {
-		if minimock.Equal(e.params, mmProcessTasks.defaultExpectation.params) {
-			mmProcessTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmProcessTasks.defaultExpectation.params)
+	mmAddTasks.defaultExpectation.params = &ITaskQueueMockAddTasksParams{ctx, specs}
+	mmAddTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddTasks.expectations {
+		if minimock.Equal(e.params, mmAddTasks.defaultExpectation.params) {
+			mmAddTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddTasks.defaultExpectation.params)
 		}
 	}
 
-	return mmProcessTasks
+	return mmAddTasks
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.ProcessTasks
-func (mmProcessTasks *mITaskQueueMockProcessTasks) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockProcessTasks {
-	if mmProcessTasks.mock.funcProcessTasks != nil {
-		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.AddTasks
+func (mmAddTasks *mITaskQueueMockAddTasks) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockAddTasks {
+	if mmAddTasks.mock.funcAddTasks != nil {
+		mmAddTasks.mock.t.Fatalf("ITaskQueueMock.AddTasks mock is already set by Set")
 	}
 
-	if mmProcessTasks.defaultExpectation == nil {
-		mmProcessTasks.defaultExpectation = &ITaskQueueMockProcessTasksExpectation{}
+	if mmAddTasks.defaultExpectation == nil {
+		mmAddTasks.defaultExpectation = &ITaskQueueMockAddTasksExpectation{}
 	}
 
-	if mmProcessTasks.defaultExpectation.params != nil {
-		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by Expect")
+	if mmAddTasks.defaultExpectation.params != nil {
+		mmAddTasks.mock.t.Fatalf("ITaskQueueMock.AddTasks mock is already set by Expect")
 	}
 
-	if mmProcessTasks.defaultExpectation.paramPtrs == nil {
-		mmProcessTasks.defaultExpectation.paramPtrs = &ITaskQueueMockProcessTasksParamPtrs{}
+	if mmAddTasks.defaultExpectation.paramPtrs == nil {
+		mmAddTasks.defaultExpectation.paramPtrs = &ITaskQueueMockAddTasksParamPtrs{}
 	}
-	mmProcessTasks.defaultExpectation.paramPtrs.ctx = &ctx
-	mmProcessTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAddTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmProcessTasks
+	return mmAddTasks
 }
 
-// Inspect accepts an inspector function that has same arguments as the ITaskQueue.ProcessTasks
-func (mmProcessTasks *mITaskQueueMockProcessTasks) Inspect(f func(ctx context.Context)) *mITaskQueueMockProcessTasks {
-	if mmProcessTasks.mock.inspectFuncProcessTasks != nil {
-		mmProcessTasks.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.ProcessTasks")
+// ExpectSpecsParam2 sets up expected param specs for ITaskQueue.AddTasks
+func (mmAddTasks *mITaskQueueMockAddTasks) ExpectSpecsParam2(specs []mm_queue.TaskSpec) *mITaskQueueMockAddTasks {
+	if mmAddTasks.mock.funcAddTasks != nil {
+		mmAddTasks.mock.t.Fatalf("ITaskQueueMock.AddTasks mock is already set by Set")
 	}
 
-	mmProcessTasks.mock.inspectFuncProcessTasks = f
+	if mmAddTasks.defaultExpectation == nil {
+		mmAddTasks.defaultExpectation = &ITaskQueueMockAddTasksExpectation{}
+	}
 
-	return mmProcessTasks
+	if mmAddTasks.defaultExpectation.params != nil {
+		mmAddTasks.mock.t.Fatalf("ITaskQueueMock.AddTasks mock is already set by Expect")
+	}
+
+	if mmAddTasks.defaultExpectation.paramPtrs == nil {
+		mmAddTasks.defaultExpectation.paramPtrs = &ITaskQueueMockAddTasksParamPtrs{}
+	}
+	mmAddTasks.defaultExpectation.paramPtrs.specs = &specs
+	mmAddTasks.defaultExpectation.expectationOrigins.originSpecs = minimock.CallerInfo(1)
+
+	return mmAddTasks
 }
 
-// Return sets up results that will be returned by ITaskQueue.ProcessTasks
-func (mmProcessTasks *mITaskQueueMockProcessTasks) Return() *ITaskQueueMock {
-	if mmProcessTasks.mock.funcProcessTasks != nil {
-		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.AddTasks
+func (mmAddTasks *mITaskQueueMockAddTasks) Inspect(f func(ctx context.Context, specs []mm_queue.TaskSpec)) *mITaskQueueMockAddTasks {
+	if mmAddTasks.mock.inspectFuncAddTasks != nil {
+		mmAddTasks.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.AddTasks")
 	}
 
-	if mmProcessTasks.defaultExpectation == nil {
-		mmProcessTasks.defaultExpectation = &ITaskQueueMockProcessTasksExpectation{mock: mmProcessTasks.mock}
+	mmAddTasks.mock.inspectFuncAddTasks = f
+
+	return mmAddTasks
+}
+
+// Return sets up results that will be returned by ITaskQueue.AddTasks
+func (mmAddTasks *mITaskQueueMockAddTasks) Return(aa1 []mm_queue.AddTaskResult, err error) *ITaskQueueMock {
+	if mmAddTasks.mock.funcAddTasks != nil {
+		mmAddTasks.mock.t.Fatalf("ITaskQueueMock.AddTasks mock is already set by Set")
 	}
 
-	mmProcessTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmProcessTasks.mock
+	if mmAddTasks.defaultExpectation == nil {
+		mmAddTasks.defaultExpectation = &ITaskQueueMockAddTasksExpectation{mock: mmAddTasks.mock}
+	}
+	mmAddTasks.defaultExpectation.results = &ITaskQueueMockAddTasksResults{aa1, err}
+	mmAddTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddTasks.mock
 }
 
-// Set uses given function f to mock the ITaskQueue.ProcessTasks method
-func (mmProcessTasks *mITaskQueueMockProcessTasks) Set(f func(ctx context.Context)) *ITaskQueueMock {
-	if mmProcessTasks.defaultExpectation != nil {
-		mmProcessTasks.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.ProcessTasks method")
+// Set uses given function f to mock the ITaskQueue.AddTasks method
+func (mmAddTasks *mITaskQueueMockAddTasks) Set(f func(ctx context.Context, specs []mm_queue.TaskSpec) (aa1 []mm_queue.AddTaskResult, err error)) *ITaskQueueMock {
+	if mmAddTasks.defaultExpectation != nil {
+		mmAddTasks.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.AddTasks method")
 	}
 
-	if len(mmProcessTasks.expectations) > 0 {
-		mmProcessTasks.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.ProcessTasks method")
+	if len(mmAddTasks.expectations) > 0 {
+		mmAddTasks.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.AddTasks method")
 	}
 
-	mmProcessTasks.mock.funcProcessTasks = f
-	mmProcessTasks.mock.funcProcessTasksOrigin = minimock.CallerInfo(1)
-	return mmProcessTasks.mock
+	mmAddTasks.mock.funcAddTasks = f
+	mmAddTasks.mock.funcAddTasksOrigin = minimock.CallerInfo(1)
+	return mmAddTasks.mock
 }
 
-// When sets expectation for the ITaskQueue.ProcessTasks which will trigger the result defined by the following
+// When sets expectation for the ITaskQueue.AddTasks which will trigger the result defined by the following
 // Then helper
-func (mmProcessTasks *mITaskQueueMockProcessTasks) When(ctx context.Context) *ITaskQueueMockProcessTasksExpectation {
-	if mmProcessTasks.mock.funcProcessTasks != nil {
-		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by Set")
+func (mmAddTasks *mITaskQueueMockAddTasks) When(ctx context.Context, specs []mm_queue.TaskSpec) *ITaskQueueMockAddTasksExpectation {
+	if mmAddTasks.mock.funcAddTasks != nil {
+		mmAddTasks.mock.t.Fatalf("ITaskQueueMock.AddTasks mock is already set by Set")
 	}
 
-	expectation := &ITaskQueueMockProcessTasksExpectation{
-		mock:               mmProcessTasks.mock,
-		params:             &ITaskQueueMockProcessTasksParams{ctx},
-		expectationOrigins: ITaskQueueMockProcessTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ITaskQueueMockAddTasksExpectation{
+		mock:               mmAddTasks.mock,
+		params:             &ITaskQueueMockAddTasksParams{ctx, specs},
+		expectationOrigins: ITaskQueueMockAddTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmProcessTasks.expectations = append(mmProcessTasks.expectations, expectation)
+	mmAddTasks.expectations = append(mmAddTasks.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ITaskQueue.ProcessTasks return parameters for the expectation previously defined by the When method
-
-func (e *ITaskQueueMockProcessTasksExpectation) Then() *ITaskQueueMock {
+// Then sets up ITaskQueue.AddTasks return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockAddTasksExpectation) Then(aa1 []mm_queue.AddTaskResult, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockAddTasksResults{aa1, err}
 	return e.mock
 }
 
-// Times sets number of times ITaskQueue.ProcessTasks should be invoked
-func (mmProcessTasks *mITaskQueueMockProcessTasks) Times(n uint64) *mITaskQueueMockProcessTasks {
+// Times sets number of times ITaskQueue.AddTasks should be invoked
+func (mmAddTasks *mITaskQueueMockAddTasks) Times(n uint64) *mITaskQueueMockAddTasks {
 	if n == 0 {
-		mmProcessTasks.mock.t.Fatalf("Times of ITaskQueueMock.ProcessTasks mock can not be zero")
+		mmAddTasks.mock.t.Fatalf("Times of ITaskQueueMock.AddTasks mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmProcessTasks.expectedInvocations, n)
-	mmProcessTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmProcessTasks
+	mm_atomic.StoreUint64(&mmAddTasks.expectedInvocations, n)
+	mmAddTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddTasks
 }
 
-func (mmProcessTasks *mITaskQueueMockProcessTasks) invocationsDone() bool {
-	if len(mmProcessTasks.expectations) == 0 && mmProcessTasks.defaultExpectation == nil && mmProcessTasks.mock.funcProcessTasks == nil {
+func (mmAddTasks *mITaskQueueMockAddTasks) invocationsDone() bool {
+	if len(mmAddTasks.expectations) == 0 && mmAddTasks.defaultExpectation == nil && mmAddTasks.mock.funcAddTasks == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmProcessTasks.mock.afterProcessTasksCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmProcessTasks.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddTasks.mock.afterAddTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddTasks.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ProcessTasks implements ITaskQueue
-func (mmProcessTasks *ITaskQueueMock) ProcessTasks(ctx context.Context) {
-	mm_atomic.AddUint64(&mmProcessTasks.beforeProcessTasksCounter, 1)
-	defer mm_atomic.AddUint64(&mmProcessTasks.afterProcessTasksCounter, 1)
+// AddTasks implements ITaskQueue
+func (mmAddTasks *ITaskQueueMock) AddTasks(ctx context.Context, specs []mm_queue.TaskSpec) (aa1 []mm_queue.AddTaskResult, err error) {
+	mm_atomic.AddUint64(&mmAddTasks.beforeAddTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddTasks.afterAddTasksCounter, 1)
 
-	mmProcessTasks.t.Helper()
+	mmAddTasks.t.Helper()
 
-	if mmProcessTasks.inspectFuncProcessTasks != nil {
-		mmProcessTasks.inspectFuncProcessTasks(ctx)
+	if mmAddTasks.inspectFuncAddTasks != nil {
+		mmAddTasks.inspectFuncAddTasks(ctx, specs)
 	}
 
-	mm_params := ITaskQueueMockProcessTasksParams{ctx}
+	mm_params := ITaskQueueMockAddTasksParams{ctx, specs}
 
 	// Record call args
-	mmProcessTasks.ProcessTasksMock.mutex.Lock()
-	mmProcessTasks.ProcessTasksMock.callArgs = append(mmProcessTasks.ProcessTasksMock.callArgs, &mm_params)
-	mmProcessTasks.ProcessTasksMock.mutex.Unlock()
+	mmAddTasks.AddTasksMock.mutex.Lock()
+	mmAddTasks.AddTasksMock.callArgs = append(mmAddTasks.AddTasksMock.callArgs, &mm_params)
+	mmAddTasks.AddTasksMock.mutex.Unlock()
 
-	for _, e := range mmProcessTasks.ProcessTasksMock.expectations {
+	for _, e := range mmAddTasks.AddTasksMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return
+			return e.results.aa1, e.results.err
 		}
 	}
 
-	if mmProcessTasks.ProcessTasksMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmProcessTasks.ProcessTasksMock.defaultExpectation.Counter, 1)
-		mm_want := mmProcessTasks.ProcessTasksMock.defaultExpectation.params
-		mm_want_ptrs := mmProcessTasks.ProcessTasksMock.defaultExpectation.paramPtrs
+	if mmAddTasks.AddTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddTasks.AddTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddTasks.AddTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmAddTasks.AddTasksMock.defaultExpectation.paramPtrs
 
-		mm_got := ITaskQueueMockProcessTasksParams{ctx}
+		mm_got := ITaskQueueMockAddTasksParams{ctx, specs}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmProcessTasks.t.Errorf("ITaskQueueMock.ProcessTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmProcessTasks.ProcessTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAddTasks.t.Errorf("ITaskQueueMock.AddTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddTasks.AddTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.specs != nil && !minimock.Equal(*mm_want_ptrs.specs, mm_got.specs) {
+				mmAddTasks.t.Errorf("ITaskQueueMock.AddTasks got unexpected parameter specs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddTasks.AddTasksMock.defaultExpectation.expectationOrigins.originSpecs, *mm_want_ptrs.specs, mm_got.specs, minimock.Diff(*mm_want_ptrs.specs, mm_got.specs))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmProcessTasks.t.Errorf("ITaskQueueMock.ProcessTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmProcessTasks.ProcessTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddTasks.t.Errorf("ITaskQueueMock.AddTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddTasks.AddTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		return
-
+		mm_results := mmAddTasks.AddTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddTasks.t.Fatal("No results are set for the ITaskQueueMock.AddTasks")
+		}
+		return (*mm_results).aa1, (*mm_results).err
 	}
-	if mmProcessTasks.funcProcessTasks != nil {
-		mmProcessTasks.funcProcessTasks(ctx)
-		return
+	if mmAddTasks.funcAddTasks != nil {
+		return mmAddTasks.funcAddTasks(ctx, specs)
 	}
-	mmProcessTasks.t.Fatalf("Unexpected call to ITaskQueueMock.ProcessTasks. %v", ctx)
-
+	mmAddTasks.t.Fatalf("Unexpected call to ITaskQueueMock.AddTasks. %v %v", ctx, specs)
+	return
 }
 
-// ProcessTasksAfterCounter returns a count of finished ITaskQueueMock.ProcessTasks invocations
-func (mmProcessTasks *ITaskQueueMock) ProcessTasksAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmProcessTasks.afterProcessTasksCounter)
+// AddTasksAfterCounter returns a count of finished ITaskQueueMock.AddTasks invocations
+func (mmAddTasks *ITaskQueueMock) AddTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddTasks.afterAddTasksCounter)
 }
 
-// ProcessTasksBeforeCounter returns a count of ITaskQueueMock.ProcessTasks invocations
-func (mmProcessTasks *ITaskQueueMock) ProcessTasksBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmProcessTasks.beforeProcessTasksCounter)
+// AddTasksBeforeCounter returns a count of ITaskQueueMock.AddTasks invocations
+func (mmAddTasks *ITaskQueueMock) AddTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddTasks.beforeAddTasksCounter)
 }
 
-// Calls returns a list of arguments used in each call to ITaskQueueMock.ProcessTasks.
+// Calls returns a list of arguments used in each call to ITaskQueueMock.AddTasks.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmProcessTasks *mITaskQueueMockProcessTasks) Calls() []*ITaskQueueMockProcessTasksParams {
-	mmProcessTasks.mutex.RLock()
+func (mmAddTasks *mITaskQueueMockAddTasks) Calls() []*ITaskQueueMockAddTasksParams {
+	mmAddTasks.mutex.RLock()
 
-	argCopy := make([]*ITaskQueueMockProcessTasksParams, len(mmProcessTasks.callArgs))
-	copy(argCopy, mmProcessTasks.callArgs)
+	argCopy := make([]*ITaskQueueMockAddTasksParams, len(mmAddTasks.callArgs))
+	copy(argCopy, mmAddTasks.callArgs)
 
-	mmProcessTasks.mutex.RUnlock()
+	mmAddTasks.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockProcessTasksDone returns true if the count of the ProcessTasks invocations corresponds
+// MinimockAddTasksDone returns true if the count of the AddTasks invocations corresponds
 // the number of defined expectations
-func (m *ITaskQueueMock) MinimockProcessTasksDone() bool {
-	if m.ProcessTasksMock.optional {
+func (m *ITaskQueueMock) MinimockAddTasksDone() bool {
+	if m.AddTasksMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ProcessTasksMock.expectations {
+	for _, e := range m.AddTasksMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ProcessTasksMock.invocationsDone()
+	return m.AddTasksMock.invocationsDone()
 }
 
-// MinimockProcessTasksInspect logs each unmet expectation
-func (m *ITaskQueueMock) MinimockProcessTasksInspect() {
-	for _, e := range m.ProcessTasksMock.expectations {
+// MinimockAddTasksInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockAddTasksInspect() {
+	for _, e := range m.AddTasksMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ITaskQueueMock.ProcessTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ITaskQueueMock.AddTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterProcessTasksCounter := mm_atomic.LoadUint64(&m.afterProcessTasksCounter)
+	afterAddTasksCounter := mm_atomic.LoadUint64(&m.afterAddTasksCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ProcessTasksMock.defaultExpectation != nil && afterProcessTasksCounter < 1 {
-		if m.ProcessTasksMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ITaskQueueMock.ProcessTasks at\n%s", m.ProcessTasksMock.defaultExpectation.returnOrigin)
+	if m.AddTasksMock.defaultExpectation != nil && afterAddTasksCounter < 1 {
+		if m.AddTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.AddTasks at\n%s", m.AddTasksMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ITaskQueueMock.ProcessTasks at\n%s with params: %#v", m.ProcessTasksMock.defaultExpectation.expectationOrigins.origin, *m.ProcessTasksMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ITaskQueueMock.AddTasks at\n%s with params: %#v", m.AddTasksMock.defaultExpectation.expectationOrigins.origin, *m.AddTasksMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcProcessTasks != nil && afterProcessTasksCounter < 1 {
-		m.t.Errorf("Expected call to ITaskQueueMock.ProcessTasks at\n%s", m.funcProcessTasksOrigin)
+	if m.funcAddTasks != nil && afterAddTasksCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.AddTasks at\n%s", m.funcAddTasksOrigin)
 	}
 
-	if !m.ProcessTasksMock.invocationsDone() && afterProcessTasksCounter > 0 {
-		m.t.Errorf("Expected %d calls to ITaskQueueMock.ProcessTasks at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ProcessTasksMock.expectedInvocations), m.ProcessTasksMock.expectedInvocationsOrigin, afterProcessTasksCounter)
+	if !m.AddTasksMock.invocationsDone() && afterAddTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.AddTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddTasksMock.expectedInvocations), m.AddTasksMock.expectedInvocationsOrigin, afterAddTasksCounter)
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *ITaskQueueMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockAddTaskInspect()
+type mITaskQueueMockCancelTask struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockCancelTaskExpectation
+	expectations       []*ITaskQueueMockCancelTaskExpectation
 
-			m.MinimockProcessTasksInspect()
+	callArgs []*ITaskQueueMockCancelTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockCancelTaskExpectation specifies expectation struct of the ITaskQueue.CancelTask
+type ITaskQueueMockCancelTaskExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockCancelTaskParams
+	paramPtrs          *ITaskQueueMockCancelTaskParamPtrs
+	expectationOrigins ITaskQueueMockCancelTaskExpectationOrigins
+	results            *ITaskQueueMockCancelTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockCancelTaskParams contains parameters of the ITaskQueue.CancelTask
+type ITaskQueueMockCancelTaskParams struct {
+	ctx    context.Context
+	taskID string
+}
+
+// ITaskQueueMockCancelTaskParamPtrs contains pointers to parameters of the ITaskQueue.CancelTask
+type ITaskQueueMockCancelTaskParamPtrs struct {
+	ctx    *context.Context
+	taskID *string
+}
+
+// ITaskQueueMockCancelTaskResults contains results of the ITaskQueue.CancelTask
+type ITaskQueueMockCancelTaskResults struct {
+	tp1 *mm_queue.TaskInfo
+	err error
+}
+
+// ITaskQueueMockCancelTaskOrigins contains origins of expectations of the ITaskQueue.CancelTask
+type ITaskQueueMockCancelTaskExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancelTask *mITaskQueueMockCancelTask) Optional() *mITaskQueueMockCancelTask {
+	mmCancelTask.optional = true
+	return mmCancelTask
+}
+
+// Expect sets up expected params for ITaskQueue.CancelTask
+func (mmCancelTask *mITaskQueueMockCancelTask) Expect(ctx context.Context, taskID string) *mITaskQueueMockCancelTask {
+	if mmCancelTask.mock.funcCancelTask != nil {
+		mmCancelTask.mock.t.Fatalf("ITaskQueueMock.CancelTask mock is already set by Set")
+	}
+
+	if mmCancelTask.defaultExpectation == nil {
+		mmCancelTask.defaultExpectation = &ITaskQueueMockCancelTaskExpectation{}
+	}
+
+	if mmCancelTask.defaultExpectation.paramPtrs != nil {
+		mmCancelTask.mock.t.Fatalf("ITaskQueueMock.CancelTask mock is already set by ExpectParams functions")
+	}
+
+	mmCancelTask.defaultExpectation.params = &ITaskQueueMockCancelTaskParams{ctx, taskID}
+	mmCancelTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCancelTask.expectations {
+		if minimock.Equal(e.params, mmCancelTask.defaultExpectation.params) {
+			mmCancelTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancelTask.defaultExpectation.params)
+		}
+	}
+
+	return mmCancelTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.CancelTask
+func (mmCancelTask *mITaskQueueMockCancelTask) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockCancelTask {
+	if mmCancelTask.mock.funcCancelTask != nil {
+		mmCancelTask.mock.t.Fatalf("ITaskQueueMock.CancelTask mock is already set by Set")
+	}
+
+	if mmCancelTask.defaultExpectation == nil {
+		mmCancelTask.defaultExpectation = &ITaskQueueMockCancelTaskExpectation{}
+	}
+
+	if mmCancelTask.defaultExpectation.params != nil {
+		mmCancelTask.mock.t.Fatalf("ITaskQueueMock.CancelTask mock is already set by Expect")
+	}
+
+	if mmCancelTask.defaultExpectation.paramPtrs == nil {
+		mmCancelTask.defaultExpectation.paramPtrs = &ITaskQueueMockCancelTaskParamPtrs{}
+	}
+	mmCancelTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCancelTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCancelTask
+}
+
+// ExpectTaskIDParam2 sets up expected param taskID for ITaskQueue.CancelTask
+func (mmCancelTask *mITaskQueueMockCancelTask) ExpectTaskIDParam2(taskID string) *mITaskQueueMockCancelTask {
+	if mmCancelTask.mock.funcCancelTask != nil {
+		mmCancelTask.mock.t.Fatalf("ITaskQueueMock.CancelTask mock is already set by Set")
+	}
+
+	if mmCancelTask.defaultExpectation == nil {
+		mmCancelTask.defaultExpectation = &ITaskQueueMockCancelTaskExpectation{}
+	}
+
+	if mmCancelTask.defaultExpectation.params != nil {
+		mmCancelTask.mock.t.Fatalf("ITaskQueueMock.CancelTask mock is already set by Expect")
+	}
+
+	if mmCancelTask.defaultExpectation.paramPtrs == nil {
+		mmCancelTask.defaultExpectation.paramPtrs = &ITaskQueueMockCancelTaskParamPtrs{}
+	}
+	mmCancelTask.defaultExpectation.paramPtrs.taskID = &taskID
+	mmCancelTask.defaultExpectation.expectationOrigins.originTaskID = minimock.CallerInfo(1)
+
+	return mmCancelTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.CancelTask
+func (mmCancelTask *mITaskQueueMockCancelTask) Inspect(f func(ctx context.Context, taskID string)) *mITaskQueueMockCancelTask {
+	if mmCancelTask.mock.inspectFuncCancelTask != nil {
+		mmCancelTask.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.CancelTask")
+	}
+
+	mmCancelTask.mock.inspectFuncCancelTask = f
+
+	return mmCancelTask
+}
+
+// Return sets up results that will be returned by ITaskQueue.CancelTask
+func (mmCancelTask *mITaskQueueMockCancelTask) Return(tp1 *mm_queue.TaskInfo, err error) *ITaskQueueMock {
+	if mmCancelTask.mock.funcCancelTask != nil {
+		mmCancelTask.mock.t.Fatalf("ITaskQueueMock.CancelTask mock is already set by Set")
+	}
+
+	if mmCancelTask.defaultExpectation == nil {
+		mmCancelTask.defaultExpectation = &ITaskQueueMockCancelTaskExpectation{mock: mmCancelTask.mock}
+	}
+	mmCancelTask.defaultExpectation.results = &ITaskQueueMockCancelTaskResults{tp1, err}
+	mmCancelTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCancelTask.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.CancelTask method
+func (mmCancelTask *mITaskQueueMockCancelTask) Set(f func(ctx context.Context, taskID string) (tp1 *mm_queue.TaskInfo, err error)) *ITaskQueueMock {
+	if mmCancelTask.defaultExpectation != nil {
+		mmCancelTask.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.CancelTask method")
+	}
+
+	if len(mmCancelTask.expectations) > 0 {
+		mmCancelTask.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.CancelTask method")
+	}
+
+	mmCancelTask.mock.funcCancelTask = f
+	mmCancelTask.mock.funcCancelTaskOrigin = minimock.CallerInfo(1)
+	return mmCancelTask.mock
+}
+
+// When sets expectation for the ITaskQueue.CancelTask which will trigger the result defined by the following
+// Then helper
+func (mmCancelTask *mITaskQueueMockCancelTask) When(ctx context.Context, taskID string) *ITaskQueueMockCancelTaskExpectation {
+	if mmCancelTask.mock.funcCancelTask != nil {
+		mmCancelTask.mock.t.Fatalf("ITaskQueueMock.CancelTask mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockCancelTaskExpectation{
+		mock:               mmCancelTask.mock,
+		params:             &ITaskQueueMockCancelTaskParams{ctx, taskID},
+		expectationOrigins: ITaskQueueMockCancelTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCancelTask.expectations = append(mmCancelTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.CancelTask return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockCancelTaskExpectation) Then(tp1 *mm_queue.TaskInfo, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockCancelTaskResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.CancelTask should be invoked
+func (mmCancelTask *mITaskQueueMockCancelTask) Times(n uint64) *mITaskQueueMockCancelTask {
+	if n == 0 {
+		mmCancelTask.mock.t.Fatalf("Times of ITaskQueueMock.CancelTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancelTask.expectedInvocations, n)
+	mmCancelTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCancelTask
+}
+
+func (mmCancelTask *mITaskQueueMockCancelTask) invocationsDone() bool {
+	if len(mmCancelTask.expectations) == 0 && mmCancelTask.defaultExpectation == nil && mmCancelTask.mock.funcCancelTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancelTask.mock.afterCancelTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancelTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CancelTask implements ITaskQueue
+func (mmCancelTask *ITaskQueueMock) CancelTask(ctx context.Context, taskID string) (tp1 *mm_queue.TaskInfo, err error) {
+	mm_atomic.AddUint64(&mmCancelTask.beforeCancelTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancelTask.afterCancelTaskCounter, 1)
+
+	mmCancelTask.t.Helper()
+
+	if mmCancelTask.inspectFuncCancelTask != nil {
+		mmCancelTask.inspectFuncCancelTask(ctx, taskID)
+	}
+
+	mm_params := ITaskQueueMockCancelTaskParams{ctx, taskID}
+
+	// Record call args
+	mmCancelTask.CancelTaskMock.mutex.Lock()
+	mmCancelTask.CancelTaskMock.callArgs = append(mmCancelTask.CancelTaskMock.callArgs, &mm_params)
+	mmCancelTask.CancelTaskMock.mutex.Unlock()
+
+	for _, e := range mmCancelTask.CancelTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmCancelTask.CancelTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancelTask.CancelTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancelTask.CancelTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmCancelTask.CancelTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockCancelTaskParams{ctx, taskID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancelTask.t.Errorf("ITaskQueueMock.CancelTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelTask.CancelTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskID != nil && !minimock.Equal(*mm_want_ptrs.taskID, mm_got.taskID) {
+				mmCancelTask.t.Errorf("ITaskQueueMock.CancelTask got unexpected parameter taskID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelTask.CancelTaskMock.defaultExpectation.expectationOrigins.originTaskID, *mm_want_ptrs.taskID, mm_got.taskID, minimock.Diff(*mm_want_ptrs.taskID, mm_got.taskID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancelTask.t.Errorf("ITaskQueueMock.CancelTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCancelTask.CancelTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancelTask.CancelTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancelTask.t.Fatal("No results are set for the ITaskQueueMock.CancelTask")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmCancelTask.funcCancelTask != nil {
+		return mmCancelTask.funcCancelTask(ctx, taskID)
+	}
+	mmCancelTask.t.Fatalf("Unexpected call to ITaskQueueMock.CancelTask. %v %v", ctx, taskID)
+	return
+}
+
+// CancelTaskAfterCounter returns a count of finished ITaskQueueMock.CancelTask invocations
+func (mmCancelTask *ITaskQueueMock) CancelTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelTask.afterCancelTaskCounter)
+}
+
+// CancelTaskBeforeCounter returns a count of ITaskQueueMock.CancelTask invocations
+func (mmCancelTask *ITaskQueueMock) CancelTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelTask.beforeCancelTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.CancelTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancelTask *mITaskQueueMockCancelTask) Calls() []*ITaskQueueMockCancelTaskParams {
+	mmCancelTask.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockCancelTaskParams, len(mmCancelTask.callArgs))
+	copy(argCopy, mmCancelTask.callArgs)
+
+	mmCancelTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancelTaskDone returns true if the count of the CancelTask invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockCancelTaskDone() bool {
+	if m.CancelTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancelTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancelTaskMock.invocationsDone()
+}
+
+// MinimockCancelTaskInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockCancelTaskInspect() {
+	for _, e := range m.CancelTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.CancelTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCancelTaskCounter := mm_atomic.LoadUint64(&m.afterCancelTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancelTaskMock.defaultExpectation != nil && afterCancelTaskCounter < 1 {
+		if m.CancelTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.CancelTask at\n%s", m.CancelTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.CancelTask at\n%s with params: %#v", m.CancelTaskMock.defaultExpectation.expectationOrigins.origin, *m.CancelTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancelTask != nil && afterCancelTaskCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.CancelTask at\n%s", m.funcCancelTaskOrigin)
+	}
+
+	if !m.CancelTaskMock.invocationsDone() && afterCancelTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.CancelTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CancelTaskMock.expectedInvocations), m.CancelTaskMock.expectedInvocationsOrigin, afterCancelTaskCounter)
+	}
+}
+
+type mITaskQueueMockGetDeadTask struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockGetDeadTaskExpectation
+	expectations       []*ITaskQueueMockGetDeadTaskExpectation
+
+	callArgs []*ITaskQueueMockGetDeadTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockGetDeadTaskExpectation specifies expectation struct of the ITaskQueue.GetDeadTask
+type ITaskQueueMockGetDeadTaskExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockGetDeadTaskParams
+	paramPtrs          *ITaskQueueMockGetDeadTaskParamPtrs
+	expectationOrigins ITaskQueueMockGetDeadTaskExpectationOrigins
+	results            *ITaskQueueMockGetDeadTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockGetDeadTaskParams contains parameters of the ITaskQueue.GetDeadTask
+type ITaskQueueMockGetDeadTaskParams struct {
+	ctx    context.Context
+	taskID string
+}
+
+// ITaskQueueMockGetDeadTaskParamPtrs contains pointers to parameters of the ITaskQueue.GetDeadTask
+type ITaskQueueMockGetDeadTaskParamPtrs struct {
+	ctx    *context.Context
+	taskID *string
+}
+
+// ITaskQueueMockGetDeadTaskResults contains results of the ITaskQueue.GetDeadTask
+type ITaskQueueMockGetDeadTaskResults struct {
+	dp1 *mm_queue.DeadTask
+	err error
+}
+
+// ITaskQueueMockGetDeadTaskOrigins contains origins of expectations of the ITaskQueue.GetDeadTask
+type ITaskQueueMockGetDeadTaskExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDeadTask *mITaskQueueMockGetDeadTask) Optional() *mITaskQueueMockGetDeadTask {
+	mmGetDeadTask.optional = true
+	return mmGetDeadTask
+}
+
+// Expect sets up expected params for ITaskQueue.GetDeadTask
+func (mmGetDeadTask *mITaskQueueMockGetDeadTask) Expect(ctx context.Context, taskID string) *mITaskQueueMockGetDeadTask {
+	if mmGetDeadTask.mock.funcGetDeadTask != nil {
+		mmGetDeadTask.mock.t.Fatalf("ITaskQueueMock.GetDeadTask mock is already set by Set")
+	}
+
+	if mmGetDeadTask.defaultExpectation == nil {
+		mmGetDeadTask.defaultExpectation = &ITaskQueueMockGetDeadTaskExpectation{}
+	}
+
+	if mmGetDeadTask.defaultExpectation.paramPtrs != nil {
+		mmGetDeadTask.mock.t.Fatalf("ITaskQueueMock.GetDeadTask mock is already set by ExpectParams functions")
+	}
+
+	mmGetDeadTask.defaultExpectation.params = &ITaskQueueMockGetDeadTaskParams{ctx, taskID}
+	mmGetDeadTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDeadTask.expectations {
+		if minimock.Equal(e.params, mmGetDeadTask.defaultExpectation.params) {
+			mmGetDeadTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDeadTask.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDeadTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.GetDeadTask
+func (mmGetDeadTask *mITaskQueueMockGetDeadTask) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockGetDeadTask {
+	if mmGetDeadTask.mock.funcGetDeadTask != nil {
+		mmGetDeadTask.mock.t.Fatalf("ITaskQueueMock.GetDeadTask mock is already set by Set")
+	}
+
+	if mmGetDeadTask.defaultExpectation == nil {
+		mmGetDeadTask.defaultExpectation = &ITaskQueueMockGetDeadTaskExpectation{}
+	}
+
+	if mmGetDeadTask.defaultExpectation.params != nil {
+		mmGetDeadTask.mock.t.Fatalf("ITaskQueueMock.GetDeadTask mock is already set by Expect")
+	}
+
+	if mmGetDeadTask.defaultExpectation.paramPtrs == nil {
+		mmGetDeadTask.defaultExpectation.paramPtrs = &ITaskQueueMockGetDeadTaskParamPtrs{}
+	}
+	mmGetDeadTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDeadTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDeadTask
+}
+
+// ExpectTaskIDParam2 sets up expected param taskID for ITaskQueue.GetDeadTask
+func (mmGetDeadTask *mITaskQueueMockGetDeadTask) ExpectTaskIDParam2(taskID string) *mITaskQueueMockGetDeadTask {
+	if mmGetDeadTask.mock.funcGetDeadTask != nil {
+		mmGetDeadTask.mock.t.Fatalf("ITaskQueueMock.GetDeadTask mock is already set by Set")
+	}
+
+	if mmGetDeadTask.defaultExpectation == nil {
+		mmGetDeadTask.defaultExpectation = &ITaskQueueMockGetDeadTaskExpectation{}
+	}
+
+	if mmGetDeadTask.defaultExpectation.params != nil {
+		mmGetDeadTask.mock.t.Fatalf("ITaskQueueMock.GetDeadTask mock is already set by Expect")
+	}
+
+	if mmGetDeadTask.defaultExpectation.paramPtrs == nil {
+		mmGetDeadTask.defaultExpectation.paramPtrs = &ITaskQueueMockGetDeadTaskParamPtrs{}
+	}
+	mmGetDeadTask.defaultExpectation.paramPtrs.taskID = &taskID
+	mmGetDeadTask.defaultExpectation.expectationOrigins.originTaskID = minimock.CallerInfo(1)
+
+	return mmGetDeadTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.GetDeadTask
+func (mmGetDeadTask *mITaskQueueMockGetDeadTask) Inspect(f func(ctx context.Context, taskID string)) *mITaskQueueMockGetDeadTask {
+	if mmGetDeadTask.mock.inspectFuncGetDeadTask != nil {
+		mmGetDeadTask.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.GetDeadTask")
+	}
+
+	mmGetDeadTask.mock.inspectFuncGetDeadTask = f
+
+	return mmGetDeadTask
+}
+
+// Return sets up results that will be returned by ITaskQueue.GetDeadTask
+func (mmGetDeadTask *mITaskQueueMockGetDeadTask) Return(dp1 *mm_queue.DeadTask, err error) *ITaskQueueMock {
+	if mmGetDeadTask.mock.funcGetDeadTask != nil {
+		mmGetDeadTask.mock.t.Fatalf("ITaskQueueMock.GetDeadTask mock is already set by Set")
+	}
+
+	if mmGetDeadTask.defaultExpectation == nil {
+		mmGetDeadTask.defaultExpectation = &ITaskQueueMockGetDeadTaskExpectation{mock: mmGetDeadTask.mock}
+	}
+	mmGetDeadTask.defaultExpectation.results = &ITaskQueueMockGetDeadTaskResults{dp1, err}
+	mmGetDeadTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDeadTask.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.GetDeadTask method
+func (mmGetDeadTask *mITaskQueueMockGetDeadTask) Set(f func(ctx context.Context, taskID string) (dp1 *mm_queue.DeadTask, err error)) *ITaskQueueMock {
+	if mmGetDeadTask.defaultExpectation != nil {
+		mmGetDeadTask.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.GetDeadTask method")
+	}
+
+	if len(mmGetDeadTask.expectations) > 0 {
+		mmGetDeadTask.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.GetDeadTask method")
+	}
+
+	mmGetDeadTask.mock.funcGetDeadTask = f
+	mmGetDeadTask.mock.funcGetDeadTaskOrigin = minimock.CallerInfo(1)
+	return mmGetDeadTask.mock
+}
+
+// When sets expectation for the ITaskQueue.GetDeadTask which will trigger the result defined by the following
+// Then helper
+func (mmGetDeadTask *mITaskQueueMockGetDeadTask) When(ctx context.Context, taskID string) *ITaskQueueMockGetDeadTaskExpectation {
+	if mmGetDeadTask.mock.funcGetDeadTask != nil {
+		mmGetDeadTask.mock.t.Fatalf("ITaskQueueMock.GetDeadTask mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockGetDeadTaskExpectation{
+		mock:               mmGetDeadTask.mock,
+		params:             &ITaskQueueMockGetDeadTaskParams{ctx, taskID},
+		expectationOrigins: ITaskQueueMockGetDeadTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDeadTask.expectations = append(mmGetDeadTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.GetDeadTask return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockGetDeadTaskExpectation) Then(dp1 *mm_queue.DeadTask, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockGetDeadTaskResults{dp1, err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.GetDeadTask should be invoked
+func (mmGetDeadTask *mITaskQueueMockGetDeadTask) Times(n uint64) *mITaskQueueMockGetDeadTask {
+	if n == 0 {
+		mmGetDeadTask.mock.t.Fatalf("Times of ITaskQueueMock.GetDeadTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDeadTask.expectedInvocations, n)
+	mmGetDeadTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDeadTask
+}
+
+func (mmGetDeadTask *mITaskQueueMockGetDeadTask) invocationsDone() bool {
+	if len(mmGetDeadTask.expectations) == 0 && mmGetDeadTask.defaultExpectation == nil && mmGetDeadTask.mock.funcGetDeadTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDeadTask.mock.afterGetDeadTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDeadTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDeadTask implements ITaskQueue
+func (mmGetDeadTask *ITaskQueueMock) GetDeadTask(ctx context.Context, taskID string) (dp1 *mm_queue.DeadTask, err error) {
+	mm_atomic.AddUint64(&mmGetDeadTask.beforeGetDeadTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDeadTask.afterGetDeadTaskCounter, 1)
+
+	mmGetDeadTask.t.Helper()
+
+	if mmGetDeadTask.inspectFuncGetDeadTask != nil {
+		mmGetDeadTask.inspectFuncGetDeadTask(ctx, taskID)
+	}
+
+	mm_params := ITaskQueueMockGetDeadTaskParams{ctx, taskID}
+
+	// Record call args
+	mmGetDeadTask.GetDeadTaskMock.mutex.Lock()
+	mmGetDeadTask.GetDeadTaskMock.callArgs = append(mmGetDeadTask.GetDeadTaskMock.callArgs, &mm_params)
+	mmGetDeadTask.GetDeadTaskMock.mutex.Unlock()
+
+	for _, e := range mmGetDeadTask.GetDeadTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dp1, e.results.err
+		}
+	}
+
+	if mmGetDeadTask.GetDeadTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDeadTask.GetDeadTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDeadTask.GetDeadTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDeadTask.GetDeadTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockGetDeadTaskParams{ctx, taskID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDeadTask.t.Errorf("ITaskQueueMock.GetDeadTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeadTask.GetDeadTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskID != nil && !minimock.Equal(*mm_want_ptrs.taskID, mm_got.taskID) {
+				mmGetDeadTask.t.Errorf("ITaskQueueMock.GetDeadTask got unexpected parameter taskID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDeadTask.GetDeadTaskMock.defaultExpectation.expectationOrigins.originTaskID, *mm_want_ptrs.taskID, mm_got.taskID, minimock.Diff(*mm_want_ptrs.taskID, mm_got.taskID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDeadTask.t.Errorf("ITaskQueueMock.GetDeadTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDeadTask.GetDeadTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDeadTask.GetDeadTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDeadTask.t.Fatal("No results are set for the ITaskQueueMock.GetDeadTask")
+		}
+		return (*mm_results).dp1, (*mm_results).err
+	}
+	if mmGetDeadTask.funcGetDeadTask != nil {
+		return mmGetDeadTask.funcGetDeadTask(ctx, taskID)
+	}
+	mmGetDeadTask.t.Fatalf("Unexpected call to ITaskQueueMock.GetDeadTask. %v %v", ctx, taskID)
+	return
+}
+
+// GetDeadTaskAfterCounter returns a count of finished ITaskQueueMock.GetDeadTask invocations
+func (mmGetDeadTask *ITaskQueueMock) GetDeadTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeadTask.afterGetDeadTaskCounter)
+}
+
+// GetDeadTaskBeforeCounter returns a count of ITaskQueueMock.GetDeadTask invocations
+func (mmGetDeadTask *ITaskQueueMock) GetDeadTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDeadTask.beforeGetDeadTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.GetDeadTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDeadTask *mITaskQueueMockGetDeadTask) Calls() []*ITaskQueueMockGetDeadTaskParams {
+	mmGetDeadTask.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockGetDeadTaskParams, len(mmGetDeadTask.callArgs))
+	copy(argCopy, mmGetDeadTask.callArgs)
+
+	mmGetDeadTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDeadTaskDone returns true if the count of the GetDeadTask invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockGetDeadTaskDone() bool {
+	if m.GetDeadTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDeadTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDeadTaskMock.invocationsDone()
+}
+
+// MinimockGetDeadTaskInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockGetDeadTaskInspect() {
+	for _, e := range m.GetDeadTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.GetDeadTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDeadTaskCounter := mm_atomic.LoadUint64(&m.afterGetDeadTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDeadTaskMock.defaultExpectation != nil && afterGetDeadTaskCounter < 1 {
+		if m.GetDeadTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.GetDeadTask at\n%s", m.GetDeadTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.GetDeadTask at\n%s with params: %#v", m.GetDeadTaskMock.defaultExpectation.expectationOrigins.origin, *m.GetDeadTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDeadTask != nil && afterGetDeadTaskCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.GetDeadTask at\n%s", m.funcGetDeadTaskOrigin)
+	}
+
+	if !m.GetDeadTaskMock.invocationsDone() && afterGetDeadTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.GetDeadTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDeadTaskMock.expectedInvocations), m.GetDeadTaskMock.expectedInvocationsOrigin, afterGetDeadTaskCounter)
+	}
+}
+
+type mITaskQueueMockGetResult struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockGetResultExpectation
+	expectations       []*ITaskQueueMockGetResultExpectation
+
+	callArgs []*ITaskQueueMockGetResultParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockGetResultExpectation specifies expectation struct of the ITaskQueue.GetResult
+type ITaskQueueMockGetResultExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockGetResultParams
+	paramPtrs          *ITaskQueueMockGetResultParamPtrs
+	expectationOrigins ITaskQueueMockGetResultExpectationOrigins
+	results            *ITaskQueueMockGetResultResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockGetResultParams contains parameters of the ITaskQueue.GetResult
+type ITaskQueueMockGetResultParams struct {
+	ctx    context.Context
+	taskID string
+}
+
+// ITaskQueueMockGetResultParamPtrs contains pointers to parameters of the ITaskQueue.GetResult
+type ITaskQueueMockGetResultParamPtrs struct {
+	ctx    *context.Context
+	taskID *string
+}
+
+// ITaskQueueMockGetResultResults contains results of the ITaskQueue.GetResult
+type ITaskQueueMockGetResultResults struct {
+	tp1 *mm_queue.TaskResult
+	err error
+}
+
+// ITaskQueueMockGetResultOrigins contains origins of expectations of the ITaskQueue.GetResult
+type ITaskQueueMockGetResultExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetResult *mITaskQueueMockGetResult) Optional() *mITaskQueueMockGetResult {
+	mmGetResult.optional = true
+	return mmGetResult
+}
+
+// Expect sets up expected params for ITaskQueue.GetResult
+func (mmGetResult *mITaskQueueMockGetResult) Expect(ctx context.Context, taskID string) *mITaskQueueMockGetResult {
+	if mmGetResult.mock.funcGetResult != nil {
+		mmGetResult.mock.t.Fatalf("ITaskQueueMock.GetResult mock is already set by Set")
+	}
+
+	if mmGetResult.defaultExpectation == nil {
+		mmGetResult.defaultExpectation = &ITaskQueueMockGetResultExpectation{}
+	}
+
+	if mmGetResult.defaultExpectation.paramPtrs != nil {
+		mmGetResult.mock.t.Fatalf("ITaskQueueMock.GetResult mock is already set by ExpectParams functions")
+	}
+
+	mmGetResult.defaultExpectation.params = &ITaskQueueMockGetResultParams{ctx, taskID}
+	mmGetResult.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetResult.expectations {
+		if minimock.Equal(e.params, mmGetResult.defaultExpectation.params) {
+			mmGetResult.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetResult.defaultExpectation.params)
+		}
+	}
+
+	return mmGetResult
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.GetResult
+func (mmGetResult *mITaskQueueMockGetResult) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockGetResult {
+	if mmGetResult.mock.funcGetResult != nil {
+		mmGetResult.mock.t.Fatalf("ITaskQueueMock.GetResult mock is already set by Set")
+	}
+
+	if mmGetResult.defaultExpectation == nil {
+		mmGetResult.defaultExpectation = &ITaskQueueMockGetResultExpectation{}
+	}
+
+	if mmGetResult.defaultExpectation.params != nil {
+		mmGetResult.mock.t.Fatalf("ITaskQueueMock.GetResult mock is already set by Expect")
+	}
+
+	if mmGetResult.defaultExpectation.paramPtrs == nil {
+		mmGetResult.defaultExpectation.paramPtrs = &ITaskQueueMockGetResultParamPtrs{}
+	}
+	mmGetResult.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetResult.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetResult
+}
+
+// ExpectTaskIDParam2 sets up expected param taskID for ITaskQueue.GetResult
+func (mmGetResult *mITaskQueueMockGetResult) ExpectTaskIDParam2(taskID string) *mITaskQueueMockGetResult {
+	if mmGetResult.mock.funcGetResult != nil {
+		mmGetResult.mock.t.Fatalf("ITaskQueueMock.GetResult mock is already set by Set")
+	}
+
+	if mmGetResult.defaultExpectation == nil {
+		mmGetResult.defaultExpectation = &ITaskQueueMockGetResultExpectation{}
+	}
+
+	if mmGetResult.defaultExpectation.params != nil {
+		mmGetResult.mock.t.Fatalf("ITaskQueueMock.GetResult mock is already set by Expect")
+	}
+
+	if mmGetResult.defaultExpectation.paramPtrs == nil {
+		mmGetResult.defaultExpectation.paramPtrs = &ITaskQueueMockGetResultParamPtrs{}
+	}
+	mmGetResult.defaultExpectation.paramPtrs.taskID = &taskID
+	mmGetResult.defaultExpectation.expectationOrigins.originTaskID = minimock.CallerInfo(1)
+
+	return mmGetResult
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.GetResult
+func (mmGetResult *mITaskQueueMockGetResult) Inspect(f func(ctx context.Context, taskID string)) *mITaskQueueMockGetResult {
+	if mmGetResult.mock.inspectFuncGetResult != nil {
+		mmGetResult.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.GetResult")
+	}
+
+	mmGetResult.mock.inspectFuncGetResult = f
+
+	return mmGetResult
+}
+
+// Return sets up results that will be returned by ITaskQueue.GetResult
+func (mmGetResult *mITaskQueueMockGetResult) Return(tp1 *mm_queue.TaskResult, err error) *ITaskQueueMock {
+	if mmGetResult.mock.funcGetResult != nil {
+		mmGetResult.mock.t.Fatalf("ITaskQueueMock.GetResult mock is already set by Set")
+	}
+
+	if mmGetResult.defaultExpectation == nil {
+		mmGetResult.defaultExpectation = &ITaskQueueMockGetResultExpectation{mock: mmGetResult.mock}
+	}
+	mmGetResult.defaultExpectation.results = &ITaskQueueMockGetResultResults{tp1, err}
+	mmGetResult.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetResult.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.GetResult method
+func (mmGetResult *mITaskQueueMockGetResult) Set(f func(ctx context.Context, taskID string) (tp1 *mm_queue.TaskResult, err error)) *ITaskQueueMock {
+	if mmGetResult.defaultExpectation != nil {
+		mmGetResult.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.GetResult method")
+	}
+
+	if len(mmGetResult.expectations) > 0 {
+		mmGetResult.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.GetResult method")
+	}
+
+	mmGetResult.mock.funcGetResult = f
+	mmGetResult.mock.funcGetResultOrigin = minimock.CallerInfo(1)
+	return mmGetResult.mock
+}
+
+// When sets expectation for the ITaskQueue.GetResult which will trigger the result defined by the following
+// Then helper
+func (mmGetResult *mITaskQueueMockGetResult) When(ctx context.Context, taskID string) *ITaskQueueMockGetResultExpectation {
+	if mmGetResult.mock.funcGetResult != nil {
+		mmGetResult.mock.t.Fatalf("ITaskQueueMock.GetResult mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockGetResultExpectation{
+		mock:               mmGetResult.mock,
+		params:             &ITaskQueueMockGetResultParams{ctx, taskID},
+		expectationOrigins: ITaskQueueMockGetResultExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetResult.expectations = append(mmGetResult.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.GetResult return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockGetResultExpectation) Then(tp1 *mm_queue.TaskResult, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockGetResultResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.GetResult should be invoked
+func (mmGetResult *mITaskQueueMockGetResult) Times(n uint64) *mITaskQueueMockGetResult {
+	if n == 0 {
+		mmGetResult.mock.t.Fatalf("Times of ITaskQueueMock.GetResult mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetResult.expectedInvocations, n)
+	mmGetResult.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetResult
+}
+
+func (mmGetResult *mITaskQueueMockGetResult) invocationsDone() bool {
+	if len(mmGetResult.expectations) == 0 && mmGetResult.defaultExpectation == nil && mmGetResult.mock.funcGetResult == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetResult.mock.afterGetResultCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetResult.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetResult implements ITaskQueue
+func (mmGetResult *ITaskQueueMock) GetResult(ctx context.Context, taskID string) (tp1 *mm_queue.TaskResult, err error) {
+	mm_atomic.AddUint64(&mmGetResult.beforeGetResultCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetResult.afterGetResultCounter, 1)
+
+	mmGetResult.t.Helper()
+
+	if mmGetResult.inspectFuncGetResult != nil {
+		mmGetResult.inspectFuncGetResult(ctx, taskID)
+	}
+
+	mm_params := ITaskQueueMockGetResultParams{ctx, taskID}
+
+	// Record call args
+	mmGetResult.GetResultMock.mutex.Lock()
+	mmGetResult.GetResultMock.callArgs = append(mmGetResult.GetResultMock.callArgs, &mm_params)
+	mmGetResult.GetResultMock.mutex.Unlock()
+
+	for _, e := range mmGetResult.GetResultMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmGetResult.GetResultMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetResult.GetResultMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetResult.GetResultMock.defaultExpectation.params
+		mm_want_ptrs := mmGetResult.GetResultMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockGetResultParams{ctx, taskID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetResult.t.Errorf("ITaskQueueMock.GetResult got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetResult.GetResultMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskID != nil && !minimock.Equal(*mm_want_ptrs.taskID, mm_got.taskID) {
+				mmGetResult.t.Errorf("ITaskQueueMock.GetResult got unexpected parameter taskID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetResult.GetResultMock.defaultExpectation.expectationOrigins.originTaskID, *mm_want_ptrs.taskID, mm_got.taskID, minimock.Diff(*mm_want_ptrs.taskID, mm_got.taskID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetResult.t.Errorf("ITaskQueueMock.GetResult got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetResult.GetResultMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetResult.GetResultMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetResult.t.Fatal("No results are set for the ITaskQueueMock.GetResult")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmGetResult.funcGetResult != nil {
+		return mmGetResult.funcGetResult(ctx, taskID)
+	}
+	mmGetResult.t.Fatalf("Unexpected call to ITaskQueueMock.GetResult. %v %v", ctx, taskID)
+	return
+}
+
+// GetResultAfterCounter returns a count of finished ITaskQueueMock.GetResult invocations
+func (mmGetResult *ITaskQueueMock) GetResultAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResult.afterGetResultCounter)
+}
+
+// GetResultBeforeCounter returns a count of ITaskQueueMock.GetResult invocations
+func (mmGetResult *ITaskQueueMock) GetResultBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetResult.beforeGetResultCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.GetResult.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetResult *mITaskQueueMockGetResult) Calls() []*ITaskQueueMockGetResultParams {
+	mmGetResult.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockGetResultParams, len(mmGetResult.callArgs))
+	copy(argCopy, mmGetResult.callArgs)
+
+	mmGetResult.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetResultDone returns true if the count of the GetResult invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockGetResultDone() bool {
+	if m.GetResultMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetResultMock.invocationsDone()
+}
+
+// MinimockGetResultInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockGetResultInspect() {
+	for _, e := range m.GetResultMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.GetResult at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetResultCounter := mm_atomic.LoadUint64(&m.afterGetResultCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetResultMock.defaultExpectation != nil && afterGetResultCounter < 1 {
+		if m.GetResultMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.GetResult at\n%s", m.GetResultMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.GetResult at\n%s with params: %#v", m.GetResultMock.defaultExpectation.expectationOrigins.origin, *m.GetResultMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetResult != nil && afterGetResultCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.GetResult at\n%s", m.funcGetResultOrigin)
+	}
+
+	if !m.GetResultMock.invocationsDone() && afterGetResultCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.GetResult at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetResultMock.expectedInvocations), m.GetResultMock.expectedInvocationsOrigin, afterGetResultCounter)
+	}
+}
+
+type mITaskQueueMockGetTask struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockGetTaskExpectation
+	expectations       []*ITaskQueueMockGetTaskExpectation
+
+	callArgs []*ITaskQueueMockGetTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockGetTaskExpectation specifies expectation struct of the ITaskQueue.GetTask
+type ITaskQueueMockGetTaskExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockGetTaskParams
+	paramPtrs          *ITaskQueueMockGetTaskParamPtrs
+	expectationOrigins ITaskQueueMockGetTaskExpectationOrigins
+	results            *ITaskQueueMockGetTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockGetTaskParams contains parameters of the ITaskQueue.GetTask
+type ITaskQueueMockGetTaskParams struct {
+	ctx    context.Context
+	taskID string
+}
+
+// ITaskQueueMockGetTaskParamPtrs contains pointers to parameters of the ITaskQueue.GetTask
+type ITaskQueueMockGetTaskParamPtrs struct {
+	ctx    *context.Context
+	taskID *string
+}
+
+// ITaskQueueMockGetTaskResults contains results of the ITaskQueue.GetTask
+type ITaskQueueMockGetTaskResults struct {
+	tp1 *mm_queue.TaskInfo
+	err error
+}
+
+// ITaskQueueMockGetTaskOrigins contains origins of expectations of the ITaskQueue.GetTask
+type ITaskQueueMockGetTaskExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTask *mITaskQueueMockGetTask) Optional() *mITaskQueueMockGetTask {
+	mmGetTask.optional = true
+	return mmGetTask
+}
+
+// Expect sets up expected params for ITaskQueue.GetTask
+func (mmGetTask *mITaskQueueMockGetTask) Expect(ctx context.Context, taskID string) *mITaskQueueMockGetTask {
+	if mmGetTask.mock.funcGetTask != nil {
+		mmGetTask.mock.t.Fatalf("ITaskQueueMock.GetTask mock is already set by Set")
+	}
+
+	if mmGetTask.defaultExpectation == nil {
+		mmGetTask.defaultExpectation = &ITaskQueueMockGetTaskExpectation{}
+	}
+
+	if mmGetTask.defaultExpectation.paramPtrs != nil {
+		mmGetTask.mock.t.Fatalf("ITaskQueueMock.GetTask mock is already set by ExpectParams functions")
+	}
+
+	mmGetTask.defaultExpectation.params = &ITaskQueueMockGetTaskParams{ctx, taskID}
+	mmGetTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTask.expectations {
+		if minimock.Equal(e.params, mmGetTask.defaultExpectation.params) {
+			mmGetTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTask.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.GetTask
+func (mmGetTask *mITaskQueueMockGetTask) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockGetTask {
+	if mmGetTask.mock.funcGetTask != nil {
+		mmGetTask.mock.t.Fatalf("ITaskQueueMock.GetTask mock is already set by Set")
+	}
+
+	if mmGetTask.defaultExpectation == nil {
+		mmGetTask.defaultExpectation = &ITaskQueueMockGetTaskExpectation{}
+	}
+
+	if mmGetTask.defaultExpectation.params != nil {
+		mmGetTask.mock.t.Fatalf("ITaskQueueMock.GetTask mock is already set by Expect")
+	}
+
+	if mmGetTask.defaultExpectation.paramPtrs == nil {
+		mmGetTask.defaultExpectation.paramPtrs = &ITaskQueueMockGetTaskParamPtrs{}
+	}
+	mmGetTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTask
+}
+
+// ExpectTaskIDParam2 sets up expected param taskID for ITaskQueue.GetTask
+func (mmGetTask *mITaskQueueMockGetTask) ExpectTaskIDParam2(taskID string) *mITaskQueueMockGetTask {
+	if mmGetTask.mock.funcGetTask != nil {
+		mmGetTask.mock.t.Fatalf("ITaskQueueMock.GetTask mock is already set by Set")
+	}
+
+	if mmGetTask.defaultExpectation == nil {
+		mmGetTask.defaultExpectation = &ITaskQueueMockGetTaskExpectation{}
+	}
+
+	if mmGetTask.defaultExpectation.params != nil {
+		mmGetTask.mock.t.Fatalf("ITaskQueueMock.GetTask mock is already set by Expect")
+	}
+
+	if mmGetTask.defaultExpectation.paramPtrs == nil {
+		mmGetTask.defaultExpectation.paramPtrs = &ITaskQueueMockGetTaskParamPtrs{}
+	}
+	mmGetTask.defaultExpectation.paramPtrs.taskID = &taskID
+	mmGetTask.defaultExpectation.expectationOrigins.originTaskID = minimock.CallerInfo(1)
+
+	return mmGetTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.GetTask
+func (mmGetTask *mITaskQueueMockGetTask) Inspect(f func(ctx context.Context, taskID string)) *mITaskQueueMockGetTask {
+	if mmGetTask.mock.inspectFuncGetTask != nil {
+		mmGetTask.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.GetTask")
+	}
+
+	mmGetTask.mock.inspectFuncGetTask = f
+
+	return mmGetTask
+}
+
+// Return sets up results that will be returned by ITaskQueue.GetTask
+func (mmGetTask *mITaskQueueMockGetTask) Return(tp1 *mm_queue.TaskInfo, err error) *ITaskQueueMock {
+	if mmGetTask.mock.funcGetTask != nil {
+		mmGetTask.mock.t.Fatalf("ITaskQueueMock.GetTask mock is already set by Set")
+	}
+
+	if mmGetTask.defaultExpectation == nil {
+		mmGetTask.defaultExpectation = &ITaskQueueMockGetTaskExpectation{mock: mmGetTask.mock}
+	}
+	mmGetTask.defaultExpectation.results = &ITaskQueueMockGetTaskResults{tp1, err}
+	mmGetTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTask.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.GetTask method
+func (mmGetTask *mITaskQueueMockGetTask) Set(f func(ctx context.Context, taskID string) (tp1 *mm_queue.TaskInfo, err error)) *ITaskQueueMock {
+	if mmGetTask.defaultExpectation != nil {
+		mmGetTask.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.GetTask method")
+	}
+
+	if len(mmGetTask.expectations) > 0 {
+		mmGetTask.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.GetTask method")
+	}
+
+	mmGetTask.mock.funcGetTask = f
+	mmGetTask.mock.funcGetTaskOrigin = minimock.CallerInfo(1)
+	return mmGetTask.mock
+}
+
+// When sets expectation for the ITaskQueue.GetTask which will trigger the result defined by the following
+// Then helper
+func (mmGetTask *mITaskQueueMockGetTask) When(ctx context.Context, taskID string) *ITaskQueueMockGetTaskExpectation {
+	if mmGetTask.mock.funcGetTask != nil {
+		mmGetTask.mock.t.Fatalf("ITaskQueueMock.GetTask mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockGetTaskExpectation{
+		mock:               mmGetTask.mock,
+		params:             &ITaskQueueMockGetTaskParams{ctx, taskID},
+		expectationOrigins: ITaskQueueMockGetTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTask.expectations = append(mmGetTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.GetTask return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockGetTaskExpectation) Then(tp1 *mm_queue.TaskInfo, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockGetTaskResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.GetTask should be invoked
+func (mmGetTask *mITaskQueueMockGetTask) Times(n uint64) *mITaskQueueMockGetTask {
+	if n == 0 {
+		mmGetTask.mock.t.Fatalf("Times of ITaskQueueMock.GetTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTask.expectedInvocations, n)
+	mmGetTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTask
+}
+
+func (mmGetTask *mITaskQueueMockGetTask) invocationsDone() bool {
+	if len(mmGetTask.expectations) == 0 && mmGetTask.defaultExpectation == nil && mmGetTask.mock.funcGetTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTask.mock.afterGetTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTask implements ITaskQueue
+func (mmGetTask *ITaskQueueMock) GetTask(ctx context.Context, taskID string) (tp1 *mm_queue.TaskInfo, err error) {
+	mm_atomic.AddUint64(&mmGetTask.beforeGetTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTask.afterGetTaskCounter, 1)
+
+	mmGetTask.t.Helper()
+
+	if mmGetTask.inspectFuncGetTask != nil {
+		mmGetTask.inspectFuncGetTask(ctx, taskID)
+	}
+
+	mm_params := ITaskQueueMockGetTaskParams{ctx, taskID}
+
+	// Record call args
+	mmGetTask.GetTaskMock.mutex.Lock()
+	mmGetTask.GetTaskMock.callArgs = append(mmGetTask.GetTaskMock.callArgs, &mm_params)
+	mmGetTask.GetTaskMock.mutex.Unlock()
+
+	for _, e := range mmGetTask.GetTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmGetTask.GetTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTask.GetTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTask.GetTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTask.GetTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockGetTaskParams{ctx, taskID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTask.t.Errorf("ITaskQueueMock.GetTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTask.GetTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskID != nil && !minimock.Equal(*mm_want_ptrs.taskID, mm_got.taskID) {
+				mmGetTask.t.Errorf("ITaskQueueMock.GetTask got unexpected parameter taskID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTask.GetTaskMock.defaultExpectation.expectationOrigins.originTaskID, *mm_want_ptrs.taskID, mm_got.taskID, minimock.Diff(*mm_want_ptrs.taskID, mm_got.taskID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTask.t.Errorf("ITaskQueueMock.GetTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTask.GetTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTask.GetTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTask.t.Fatal("No results are set for the ITaskQueueMock.GetTask")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmGetTask.funcGetTask != nil {
+		return mmGetTask.funcGetTask(ctx, taskID)
+	}
+	mmGetTask.t.Fatalf("Unexpected call to ITaskQueueMock.GetTask. %v %v", ctx, taskID)
+	return
+}
+
+// GetTaskAfterCounter returns a count of finished ITaskQueueMock.GetTask invocations
+func (mmGetTask *ITaskQueueMock) GetTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTask.afterGetTaskCounter)
+}
+
+// GetTaskBeforeCounter returns a count of ITaskQueueMock.GetTask invocations
+func (mmGetTask *ITaskQueueMock) GetTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTask.beforeGetTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.GetTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTask *mITaskQueueMockGetTask) Calls() []*ITaskQueueMockGetTaskParams {
+	mmGetTask.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockGetTaskParams, len(mmGetTask.callArgs))
+	copy(argCopy, mmGetTask.callArgs)
+
+	mmGetTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTaskDone returns true if the count of the GetTask invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockGetTaskDone() bool {
+	if m.GetTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTaskMock.invocationsDone()
+}
+
+// MinimockGetTaskInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockGetTaskInspect() {
+	for _, e := range m.GetTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.GetTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTaskCounter := mm_atomic.LoadUint64(&m.afterGetTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTaskMock.defaultExpectation != nil && afterGetTaskCounter < 1 {
+		if m.GetTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.GetTask at\n%s", m.GetTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.GetTask at\n%s with params: %#v", m.GetTaskMock.defaultExpectation.expectationOrigins.origin, *m.GetTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTask != nil && afterGetTaskCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.GetTask at\n%s", m.funcGetTaskOrigin)
+	}
+
+	if !m.GetTaskMock.invocationsDone() && afterGetTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.GetTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTaskMock.expectedInvocations), m.GetTaskMock.expectedInvocationsOrigin, afterGetTaskCounter)
+	}
+}
+
+type mITaskQueueMockListDeadTasks struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockListDeadTasksExpectation
+	expectations       []*ITaskQueueMockListDeadTasksExpectation
+
+	callArgs []*ITaskQueueMockListDeadTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockListDeadTasksExpectation specifies expectation struct of the ITaskQueue.ListDeadTasks
+type ITaskQueueMockListDeadTasksExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockListDeadTasksParams
+	paramPtrs          *ITaskQueueMockListDeadTasksParamPtrs
+	expectationOrigins ITaskQueueMockListDeadTasksExpectationOrigins
+	results            *ITaskQueueMockListDeadTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockListDeadTasksParams contains parameters of the ITaskQueue.ListDeadTasks
+type ITaskQueueMockListDeadTasksParams struct {
+	ctx    context.Context
+	offset int
+	limit  int
+}
+
+// ITaskQueueMockListDeadTasksParamPtrs contains pointers to parameters of the ITaskQueue.ListDeadTasks
+type ITaskQueueMockListDeadTasksParamPtrs struct {
+	ctx    *context.Context
+	offset *int
+	limit  *int
+}
+
+// ITaskQueueMockListDeadTasksResults contains results of the ITaskQueue.ListDeadTasks
+type ITaskQueueMockListDeadTasksResults struct {
+	dp1 *mm_queue.DeadTaskPage
+	err error
+}
+
+// ITaskQueueMockListDeadTasksOrigins contains origins of expectations of the ITaskQueue.ListDeadTasks
+type ITaskQueueMockListDeadTasksExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originOffset string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) Optional() *mITaskQueueMockListDeadTasks {
+	mmListDeadTasks.optional = true
+	return mmListDeadTasks
+}
+
+// Expect sets up expected params for ITaskQueue.ListDeadTasks
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) Expect(ctx context.Context, offset int, limit int) *mITaskQueueMockListDeadTasks {
+	if mmListDeadTasks.mock.funcListDeadTasks != nil {
+		mmListDeadTasks.mock.t.Fatalf("ITaskQueueMock.ListDeadTasks mock is already set by Set")
+	}
+
+	if mmListDeadTasks.defaultExpectation == nil {
+		mmListDeadTasks.defaultExpectation = &ITaskQueueMockListDeadTasksExpectation{}
+	}
+
+	if mmListDeadTasks.defaultExpectation.paramPtrs != nil {
+		mmListDeadTasks.mock.t.Fatalf("ITaskQueueMock.ListDeadTasks mock is already set by ExpectParams functions")
+	}
+
+	mmListDeadTasks.defaultExpectation.params = &ITaskQueueMockListDeadTasksParams{ctx, offset, limit}
+	mmListDeadTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListDeadTasks.expectations {
+		if minimock.Equal(e.params, mmListDeadTasks.defaultExpectation.params) {
+			mmListDeadTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListDeadTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmListDeadTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.ListDeadTasks
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockListDeadTasks {
+	if mmListDeadTasks.mock.funcListDeadTasks != nil {
+		mmListDeadTasks.mock.t.Fatalf("ITaskQueueMock.ListDeadTasks mock is already set by Set")
+	}
+
+	if mmListDeadTasks.defaultExpectation == nil {
+		mmListDeadTasks.defaultExpectation = &ITaskQueueMockListDeadTasksExpectation{}
+	}
+
+	if mmListDeadTasks.defaultExpectation.params != nil {
+		mmListDeadTasks.mock.t.Fatalf("ITaskQueueMock.ListDeadTasks mock is already set by Expect")
+	}
+
+	if mmListDeadTasks.defaultExpectation.paramPtrs == nil {
+		mmListDeadTasks.defaultExpectation.paramPtrs = &ITaskQueueMockListDeadTasksParamPtrs{}
+	}
+	mmListDeadTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListDeadTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListDeadTasks
+}
+
+// ExpectOffsetParam2 sets up expected param offset for ITaskQueue.ListDeadTasks
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) ExpectOffsetParam2(offset int) *mITaskQueueMockListDeadTasks {
+	if mmListDeadTasks.mock.funcListDeadTasks != nil {
+		mmListDeadTasks.mock.t.Fatalf("ITaskQueueMock.ListDeadTasks mock is already set by Set")
+	}
+
+	if mmListDeadTasks.defaultExpectation == nil {
+		mmListDeadTasks.defaultExpectation = &ITaskQueueMockListDeadTasksExpectation{}
+	}
+
+	if mmListDeadTasks.defaultExpectation.params != nil {
+		mmListDeadTasks.mock.t.Fatalf("ITaskQueueMock.ListDeadTasks mock is already set by Expect")
+	}
+
+	if mmListDeadTasks.defaultExpectation.paramPtrs == nil {
+		mmListDeadTasks.defaultExpectation.paramPtrs = &ITaskQueueMockListDeadTasksParamPtrs{}
+	}
+	mmListDeadTasks.defaultExpectation.paramPtrs.offset = &offset
+	mmListDeadTasks.defaultExpectation.expectationOrigins.originOffset = minimock.CallerInfo(1)
+
+	return mmListDeadTasks
+}
+
+// ExpectLimitParam3 sets up expected param limit for ITaskQueue.ListDeadTasks
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) ExpectLimitParam3(limit int) *mITaskQueueMockListDeadTasks {
+	if mmListDeadTasks.mock.funcListDeadTasks != nil {
+		mmListDeadTasks.mock.t.Fatalf("ITaskQueueMock.ListDeadTasks mock is already set by Set")
+	}
+
+	if mmListDeadTasks.defaultExpectation == nil {
+		mmListDeadTasks.defaultExpectation = &ITaskQueueMockListDeadTasksExpectation{}
+	}
+
+	if mmListDeadTasks.defaultExpectation.params != nil {
+		mmListDeadTasks.mock.t.Fatalf("ITaskQueueMock.ListDeadTasks mock is already set by Expect")
+	}
+
+	if mmListDeadTasks.defaultExpectation.paramPtrs == nil {
+		mmListDeadTasks.defaultExpectation.paramPtrs = &ITaskQueueMockListDeadTasksParamPtrs{}
+	}
+	mmListDeadTasks.defaultExpectation.paramPtrs.limit = &limit
+	mmListDeadTasks.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListDeadTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.ListDeadTasks
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) Inspect(f func(ctx context.Context, offset int, limit int)) *mITaskQueueMockListDeadTasks {
+	if mmListDeadTasks.mock.inspectFuncListDeadTasks != nil {
+		mmListDeadTasks.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.ListDeadTasks")
+	}
+
+	mmListDeadTasks.mock.inspectFuncListDeadTasks = f
+
+	return mmListDeadTasks
+}
+
+// Return sets up results that will be returned by ITaskQueue.ListDeadTasks
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) Return(dp1 *mm_queue.DeadTaskPage, err error) *ITaskQueueMock {
+	if mmListDeadTasks.mock.funcListDeadTasks != nil {
+		mmListDeadTasks.mock.t.Fatalf("ITaskQueueMock.ListDeadTasks mock is already set by Set")
+	}
+
+	if mmListDeadTasks.defaultExpectation == nil {
+		mmListDeadTasks.defaultExpectation = &ITaskQueueMockListDeadTasksExpectation{mock: mmListDeadTasks.mock}
+	}
+	mmListDeadTasks.defaultExpectation.results = &ITaskQueueMockListDeadTasksResults{dp1, err}
+	mmListDeadTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListDeadTasks.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.ListDeadTasks method
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) Set(f func(ctx context.Context, offset int, limit int) (dp1 *mm_queue.DeadTaskPage, err error)) *ITaskQueueMock {
+	if mmListDeadTasks.defaultExpectation != nil {
+		mmListDeadTasks.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.ListDeadTasks method")
+	}
+
+	if len(mmListDeadTasks.expectations) > 0 {
+		mmListDeadTasks.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.ListDeadTasks method")
+	}
+
+	mmListDeadTasks.mock.funcListDeadTasks = f
+	mmListDeadTasks.mock.funcListDeadTasksOrigin = minimock.CallerInfo(1)
+	return mmListDeadTasks.mock
+}
+
+// When sets expectation for the ITaskQueue.ListDeadTasks which will trigger the result defined by the following
+// Then helper
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) When(ctx context.Context, offset int, limit int) *ITaskQueueMockListDeadTasksExpectation {
+	if mmListDeadTasks.mock.funcListDeadTasks != nil {
+		mmListDeadTasks.mock.t.Fatalf("ITaskQueueMock.ListDeadTasks mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockListDeadTasksExpectation{
+		mock:               mmListDeadTasks.mock,
+		params:             &ITaskQueueMockListDeadTasksParams{ctx, offset, limit},
+		expectationOrigins: ITaskQueueMockListDeadTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListDeadTasks.expectations = append(mmListDeadTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.ListDeadTasks return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockListDeadTasksExpectation) Then(dp1 *mm_queue.DeadTaskPage, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockListDeadTasksResults{dp1, err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.ListDeadTasks should be invoked
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) Times(n uint64) *mITaskQueueMockListDeadTasks {
+	if n == 0 {
+		mmListDeadTasks.mock.t.Fatalf("Times of ITaskQueueMock.ListDeadTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListDeadTasks.expectedInvocations, n)
+	mmListDeadTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListDeadTasks
+}
+
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) invocationsDone() bool {
+	if len(mmListDeadTasks.expectations) == 0 && mmListDeadTasks.defaultExpectation == nil && mmListDeadTasks.mock.funcListDeadTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListDeadTasks.mock.afterListDeadTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListDeadTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListDeadTasks implements ITaskQueue
+func (mmListDeadTasks *ITaskQueueMock) ListDeadTasks(ctx context.Context, offset int, limit int) (dp1 *mm_queue.DeadTaskPage, err error) {
+	mm_atomic.AddUint64(&mmListDeadTasks.beforeListDeadTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmListDeadTasks.afterListDeadTasksCounter, 1)
+
+	mmListDeadTasks.t.Helper()
+
+	if mmListDeadTasks.inspectFuncListDeadTasks != nil {
+		mmListDeadTasks.inspectFuncListDeadTasks(ctx, offset, limit)
+	}
+
+	mm_params := ITaskQueueMockListDeadTasksParams{ctx, offset, limit}
+
+	// Record call args
+	mmListDeadTasks.ListDeadTasksMock.mutex.Lock()
+	mmListDeadTasks.ListDeadTasksMock.callArgs = append(mmListDeadTasks.ListDeadTasksMock.callArgs, &mm_params)
+	mmListDeadTasks.ListDeadTasksMock.mutex.Unlock()
+
+	for _, e := range mmListDeadTasks.ListDeadTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.dp1, e.results.err
+		}
+	}
+
+	if mmListDeadTasks.ListDeadTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListDeadTasks.ListDeadTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmListDeadTasks.ListDeadTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmListDeadTasks.ListDeadTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockListDeadTasksParams{ctx, offset, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListDeadTasks.t.Errorf("ITaskQueueMock.ListDeadTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDeadTasks.ListDeadTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.offset != nil && !minimock.Equal(*mm_want_ptrs.offset, mm_got.offset) {
+				mmListDeadTasks.t.Errorf("ITaskQueueMock.ListDeadTasks got unexpected parameter offset, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDeadTasks.ListDeadTasksMock.defaultExpectation.expectationOrigins.originOffset, *mm_want_ptrs.offset, mm_got.offset, minimock.Diff(*mm_want_ptrs.offset, mm_got.offset))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListDeadTasks.t.Errorf("ITaskQueueMock.ListDeadTasks got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDeadTasks.ListDeadTasksMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListDeadTasks.t.Errorf("ITaskQueueMock.ListDeadTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListDeadTasks.ListDeadTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListDeadTasks.ListDeadTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListDeadTasks.t.Fatal("No results are set for the ITaskQueueMock.ListDeadTasks")
+		}
+		return (*mm_results).dp1, (*mm_results).err
+	}
+	if mmListDeadTasks.funcListDeadTasks != nil {
+		return mmListDeadTasks.funcListDeadTasks(ctx, offset, limit)
+	}
+	mmListDeadTasks.t.Fatalf("Unexpected call to ITaskQueueMock.ListDeadTasks. %v %v %v", ctx, offset, limit)
+	return
+}
+
+// ListDeadTasksAfterCounter returns a count of finished ITaskQueueMock.ListDeadTasks invocations
+func (mmListDeadTasks *ITaskQueueMock) ListDeadTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDeadTasks.afterListDeadTasksCounter)
+}
+
+// ListDeadTasksBeforeCounter returns a count of ITaskQueueMock.ListDeadTasks invocations
+func (mmListDeadTasks *ITaskQueueMock) ListDeadTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDeadTasks.beforeListDeadTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.ListDeadTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListDeadTasks *mITaskQueueMockListDeadTasks) Calls() []*ITaskQueueMockListDeadTasksParams {
+	mmListDeadTasks.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockListDeadTasksParams, len(mmListDeadTasks.callArgs))
+	copy(argCopy, mmListDeadTasks.callArgs)
+
+	mmListDeadTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDeadTasksDone returns true if the count of the ListDeadTasks invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockListDeadTasksDone() bool {
+	if m.ListDeadTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListDeadTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListDeadTasksMock.invocationsDone()
+}
+
+// MinimockListDeadTasksInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockListDeadTasksInspect() {
+	for _, e := range m.ListDeadTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.ListDeadTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListDeadTasksCounter := mm_atomic.LoadUint64(&m.afterListDeadTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDeadTasksMock.defaultExpectation != nil && afterListDeadTasksCounter < 1 {
+		if m.ListDeadTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.ListDeadTasks at\n%s", m.ListDeadTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.ListDeadTasks at\n%s with params: %#v", m.ListDeadTasksMock.defaultExpectation.expectationOrigins.origin, *m.ListDeadTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDeadTasks != nil && afterListDeadTasksCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.ListDeadTasks at\n%s", m.funcListDeadTasksOrigin)
+	}
+
+	if !m.ListDeadTasksMock.invocationsDone() && afterListDeadTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.ListDeadTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListDeadTasksMock.expectedInvocations), m.ListDeadTasksMock.expectedInvocationsOrigin, afterListDeadTasksCounter)
+	}
+}
+
+type mITaskQueueMockListQueues struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockListQueuesExpectation
+	expectations       []*ITaskQueueMockListQueuesExpectation
+
+	callArgs []*ITaskQueueMockListQueuesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockListQueuesExpectation specifies expectation struct of the ITaskQueue.ListQueues
+type ITaskQueueMockListQueuesExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockListQueuesParams
+	paramPtrs          *ITaskQueueMockListQueuesParamPtrs
+	expectationOrigins ITaskQueueMockListQueuesExpectationOrigins
+	results            *ITaskQueueMockListQueuesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockListQueuesParams contains parameters of the ITaskQueue.ListQueues
+type ITaskQueueMockListQueuesParams struct {
+	ctx context.Context
+}
+
+// ITaskQueueMockListQueuesParamPtrs contains pointers to parameters of the ITaskQueue.ListQueues
+type ITaskQueueMockListQueuesParamPtrs struct {
+	ctx *context.Context
+}
+
+// ITaskQueueMockListQueuesResults contains results of the ITaskQueue.ListQueues
+type ITaskQueueMockListQueuesResults struct {
+	qpa1 []*mm_queue.QueueStatus
+	err  error
+}
+
+// ITaskQueueMockListQueuesOrigins contains origins of expectations of the ITaskQueue.ListQueues
+type ITaskQueueMockListQueuesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListQueues *mITaskQueueMockListQueues) Optional() *mITaskQueueMockListQueues {
+	mmListQueues.optional = true
+	return mmListQueues
+}
+
+// Expect sets up expected params for ITaskQueue.ListQueues
+func (mmListQueues *mITaskQueueMockListQueues) Expect(ctx context.Context) *mITaskQueueMockListQueues {
+	if mmListQueues.mock.funcListQueues != nil {
+		mmListQueues.mock.t.Fatalf("ITaskQueueMock.ListQueues mock is already set by Set")
+	}
+
+	if mmListQueues.defaultExpectation == nil {
+		mmListQueues.defaultExpectation = &ITaskQueueMockListQueuesExpectation{}
+	}
+
+	if mmListQueues.defaultExpectation.paramPtrs != nil {
+		mmListQueues.mock.t.Fatalf("ITaskQueueMock.ListQueues mock is already set by ExpectParams functions")
+	}
+
+	mmListQueues.defaultExpectation.params = &ITaskQueueMockListQueuesParams{ctx}
+	mmListQueues.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListQueues.expectations {
+		if minimock.Equal(e.params, mmListQueues.defaultExpectation.params) {
+			mmListQueues.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListQueues.defaultExpectation.params)
+		}
+	}
+
+	return mmListQueues
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.ListQueues
+func (mmListQueues *mITaskQueueMockListQueues) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockListQueues {
+	if mmListQueues.mock.funcListQueues != nil {
+		mmListQueues.mock.t.Fatalf("ITaskQueueMock.ListQueues mock is already set by Set")
+	}
+
+	if mmListQueues.defaultExpectation == nil {
+		mmListQueues.defaultExpectation = &ITaskQueueMockListQueuesExpectation{}
+	}
+
+	if mmListQueues.defaultExpectation.params != nil {
+		mmListQueues.mock.t.Fatalf("ITaskQueueMock.ListQueues mock is already set by Expect")
+	}
+
+	if mmListQueues.defaultExpectation.paramPtrs == nil {
+		mmListQueues.defaultExpectation.paramPtrs = &ITaskQueueMockListQueuesParamPtrs{}
+	}
+	mmListQueues.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListQueues.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListQueues
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.ListQueues
+func (mmListQueues *mITaskQueueMockListQueues) Inspect(f func(ctx context.Context)) *mITaskQueueMockListQueues {
+	if mmListQueues.mock.inspectFuncListQueues != nil {
+		mmListQueues.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.ListQueues")
+	}
+
+	mmListQueues.mock.inspectFuncListQueues = f
+
+	return mmListQueues
+}
+
+// Return sets up results that will be returned by ITaskQueue.ListQueues
+func (mmListQueues *mITaskQueueMockListQueues) Return(qpa1 []*mm_queue.QueueStatus, err error) *ITaskQueueMock {
+	if mmListQueues.mock.funcListQueues != nil {
+		mmListQueues.mock.t.Fatalf("ITaskQueueMock.ListQueues mock is already set by Set")
+	}
+
+	if mmListQueues.defaultExpectation == nil {
+		mmListQueues.defaultExpectation = &ITaskQueueMockListQueuesExpectation{mock: mmListQueues.mock}
+	}
+	mmListQueues.defaultExpectation.results = &ITaskQueueMockListQueuesResults{qpa1, err}
+	mmListQueues.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListQueues.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.ListQueues method
+func (mmListQueues *mITaskQueueMockListQueues) Set(f func(ctx context.Context) (qpa1 []*mm_queue.QueueStatus, err error)) *ITaskQueueMock {
+	if mmListQueues.defaultExpectation != nil {
+		mmListQueues.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.ListQueues method")
+	}
+
+	if len(mmListQueues.expectations) > 0 {
+		mmListQueues.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.ListQueues method")
+	}
+
+	mmListQueues.mock.funcListQueues = f
+	mmListQueues.mock.funcListQueuesOrigin = minimock.CallerInfo(1)
+	return mmListQueues.mock
+}
+
+// When sets expectation for the ITaskQueue.ListQueues which will trigger the result defined by the following
+// Then helper
+func (mmListQueues *mITaskQueueMockListQueues) When(ctx context.Context) *ITaskQueueMockListQueuesExpectation {
+	if mmListQueues.mock.funcListQueues != nil {
+		mmListQueues.mock.t.Fatalf("ITaskQueueMock.ListQueues mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockListQueuesExpectation{
+		mock:               mmListQueues.mock,
+		params:             &ITaskQueueMockListQueuesParams{ctx},
+		expectationOrigins: ITaskQueueMockListQueuesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListQueues.expectations = append(mmListQueues.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.ListQueues return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockListQueuesExpectation) Then(qpa1 []*mm_queue.QueueStatus, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockListQueuesResults{qpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.ListQueues should be invoked
+func (mmListQueues *mITaskQueueMockListQueues) Times(n uint64) *mITaskQueueMockListQueues {
+	if n == 0 {
+		mmListQueues.mock.t.Fatalf("Times of ITaskQueueMock.ListQueues mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListQueues.expectedInvocations, n)
+	mmListQueues.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListQueues
+}
+
+func (mmListQueues *mITaskQueueMockListQueues) invocationsDone() bool {
+	if len(mmListQueues.expectations) == 0 && mmListQueues.defaultExpectation == nil && mmListQueues.mock.funcListQueues == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListQueues.mock.afterListQueuesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListQueues.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListQueues implements ITaskQueue
+func (mmListQueues *ITaskQueueMock) ListQueues(ctx context.Context) (qpa1 []*mm_queue.QueueStatus, err error) {
+	mm_atomic.AddUint64(&mmListQueues.beforeListQueuesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListQueues.afterListQueuesCounter, 1)
+
+	mmListQueues.t.Helper()
+
+	if mmListQueues.inspectFuncListQueues != nil {
+		mmListQueues.inspectFuncListQueues(ctx)
+	}
+
+	mm_params := ITaskQueueMockListQueuesParams{ctx}
+
+	// Record call args
+	mmListQueues.ListQueuesMock.mutex.Lock()
+	mmListQueues.ListQueuesMock.callArgs = append(mmListQueues.ListQueuesMock.callArgs, &mm_params)
+	mmListQueues.ListQueuesMock.mutex.Unlock()
+
+	for _, e := range mmListQueues.ListQueuesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.qpa1, e.results.err
+		}
+	}
+
+	if mmListQueues.ListQueuesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListQueues.ListQueuesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListQueues.ListQueuesMock.defaultExpectation.params
+		mm_want_ptrs := mmListQueues.ListQueuesMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockListQueuesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListQueues.t.Errorf("ITaskQueueMock.ListQueues got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListQueues.ListQueuesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListQueues.t.Errorf("ITaskQueueMock.ListQueues got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListQueues.ListQueuesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListQueues.ListQueuesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListQueues.t.Fatal("No results are set for the ITaskQueueMock.ListQueues")
+		}
+		return (*mm_results).qpa1, (*mm_results).err
+	}
+	if mmListQueues.funcListQueues != nil {
+		return mmListQueues.funcListQueues(ctx)
+	}
+	mmListQueues.t.Fatalf("Unexpected call to ITaskQueueMock.ListQueues. %v", ctx)
+	return
+}
+
+// ListQueuesAfterCounter returns a count of finished ITaskQueueMock.ListQueues invocations
+func (mmListQueues *ITaskQueueMock) ListQueuesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListQueues.afterListQueuesCounter)
+}
+
+// ListQueuesBeforeCounter returns a count of ITaskQueueMock.ListQueues invocations
+func (mmListQueues *ITaskQueueMock) ListQueuesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListQueues.beforeListQueuesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.ListQueues.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListQueues *mITaskQueueMockListQueues) Calls() []*ITaskQueueMockListQueuesParams {
+	mmListQueues.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockListQueuesParams, len(mmListQueues.callArgs))
+	copy(argCopy, mmListQueues.callArgs)
+
+	mmListQueues.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListQueuesDone returns true if the count of the ListQueues invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockListQueuesDone() bool {
+	if m.ListQueuesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListQueuesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListQueuesMock.invocationsDone()
+}
+
+// MinimockListQueuesInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockListQueuesInspect() {
+	for _, e := range m.ListQueuesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.ListQueues at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListQueuesCounter := mm_atomic.LoadUint64(&m.afterListQueuesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListQueuesMock.defaultExpectation != nil && afterListQueuesCounter < 1 {
+		if m.ListQueuesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.ListQueues at\n%s", m.ListQueuesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.ListQueues at\n%s with params: %#v", m.ListQueuesMock.defaultExpectation.expectationOrigins.origin, *m.ListQueuesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListQueues != nil && afterListQueuesCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.ListQueues at\n%s", m.funcListQueuesOrigin)
+	}
+
+	if !m.ListQueuesMock.invocationsDone() && afterListQueuesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.ListQueues at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListQueuesMock.expectedInvocations), m.ListQueuesMock.expectedInvocationsOrigin, afterListQueuesCounter)
+	}
+}
+
+type mITaskQueueMockPauseQueue struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockPauseQueueExpectation
+	expectations       []*ITaskQueueMockPauseQueueExpectation
+
+	callArgs []*ITaskQueueMockPauseQueueParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockPauseQueueExpectation specifies expectation struct of the ITaskQueue.PauseQueue
+type ITaskQueueMockPauseQueueExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockPauseQueueParams
+	paramPtrs          *ITaskQueueMockPauseQueueParamPtrs
+	expectationOrigins ITaskQueueMockPauseQueueExpectationOrigins
+	results            *ITaskQueueMockPauseQueueResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockPauseQueueParams contains parameters of the ITaskQueue.PauseQueue
+type ITaskQueueMockPauseQueueParams struct {
+	ctx   context.Context
+	name  string
+	shard int
+}
+
+// ITaskQueueMockPauseQueueParamPtrs contains pointers to parameters of the ITaskQueue.PauseQueue
+type ITaskQueueMockPauseQueueParamPtrs struct {
+	ctx   *context.Context
+	name  *string
+	shard *int
+}
+
+// ITaskQueueMockPauseQueueResults contains results of the ITaskQueue.PauseQueue
+type ITaskQueueMockPauseQueueResults struct {
+	qp1 *mm_queue.QueueStatus
+	err error
+}
+
+// ITaskQueueMockPauseQueueOrigins contains origins of expectations of the ITaskQueue.PauseQueue
+type ITaskQueueMockPauseQueueExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originName  string
+	originShard string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPauseQueue *mITaskQueueMockPauseQueue) Optional() *mITaskQueueMockPauseQueue {
+	mmPauseQueue.optional = true
+	return mmPauseQueue
+}
+
+// Expect sets up expected params for ITaskQueue.PauseQueue
+func (mmPauseQueue *mITaskQueueMockPauseQueue) Expect(ctx context.Context, name string, shard int) *mITaskQueueMockPauseQueue {
+	if mmPauseQueue.mock.funcPauseQueue != nil {
+		mmPauseQueue.mock.t.Fatalf("ITaskQueueMock.PauseQueue mock is already set by Set")
+	}
+
+	if mmPauseQueue.defaultExpectation == nil {
+		mmPauseQueue.defaultExpectation = &ITaskQueueMockPauseQueueExpectation{}
+	}
+
+	if mmPauseQueue.defaultExpectation.paramPtrs != nil {
+		mmPauseQueue.mock.t.Fatalf("ITaskQueueMock.PauseQueue mock is already set by ExpectParams functions")
+	}
+
+	mmPauseQueue.defaultExpectation.params = &ITaskQueueMockPauseQueueParams{ctx, name, shard}
+	mmPauseQueue.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPauseQueue.expectations {
+		if minimock.Equal(e.params, mmPauseQueue.defaultExpectation.params) {
+			mmPauseQueue.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPauseQueue.defaultExpectation.params)
+		}
+	}
+
+	return mmPauseQueue
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.PauseQueue
+func (mmPauseQueue *mITaskQueueMockPauseQueue) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockPauseQueue {
+	if mmPauseQueue.mock.funcPauseQueue != nil {
+		mmPauseQueue.mock.t.Fatalf("ITaskQueueMock.PauseQueue mock is already set by Set")
+	}
+
+	if mmPauseQueue.defaultExpectation == nil {
+		mmPauseQueue.defaultExpectation = &ITaskQueueMockPauseQueueExpectation{}
+	}
+
+	if mmPauseQueue.defaultExpectation.params != nil {
+		mmPauseQueue.mock.t.Fatalf("ITaskQueueMock.PauseQueue mock is already set by Expect")
+	}
+
+	if mmPauseQueue.defaultExpectation.paramPtrs == nil {
+		mmPauseQueue.defaultExpectation.paramPtrs = &ITaskQueueMockPauseQueueParamPtrs{}
+	}
+	mmPauseQueue.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPauseQueue.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPauseQueue
+}
+
+// ExpectNameParam2 sets up expected param name for ITaskQueue.PauseQueue
+func (mmPauseQueue *mITaskQueueMockPauseQueue) ExpectNameParam2(name string) *mITaskQueueMockPauseQueue {
+	if mmPauseQueue.mock.funcPauseQueue != nil {
+		mmPauseQueue.mock.t.Fatalf("ITaskQueueMock.PauseQueue mock is already set by Set")
+	}
+
+	if mmPauseQueue.defaultExpectation == nil {
+		mmPauseQueue.defaultExpectation = &ITaskQueueMockPauseQueueExpectation{}
+	}
+
+	if mmPauseQueue.defaultExpectation.params != nil {
+		mmPauseQueue.mock.t.Fatalf("ITaskQueueMock.PauseQueue mock is already set by Expect")
+	}
+
+	if mmPauseQueue.defaultExpectation.paramPtrs == nil {
+		mmPauseQueue.defaultExpectation.paramPtrs = &ITaskQueueMockPauseQueueParamPtrs{}
+	}
+	mmPauseQueue.defaultExpectation.paramPtrs.name = &name
+	mmPauseQueue.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmPauseQueue
+}
+
+// ExpectShardParam3 sets up expected param shard for ITaskQueue.PauseQueue
+func (mmPauseQueue *mITaskQueueMockPauseQueue) ExpectShardParam3(shard int) *mITaskQueueMockPauseQueue {
+	if mmPauseQueue.mock.funcPauseQueue != nil {
+		mmPauseQueue.mock.t.Fatalf("ITaskQueueMock.PauseQueue mock is already set by Set")
+	}
+
+	if mmPauseQueue.defaultExpectation == nil {
+		mmPauseQueue.defaultExpectation = &ITaskQueueMockPauseQueueExpectation{}
+	}
+
+	if mmPauseQueue.defaultExpectation.params != nil {
+		mmPauseQueue.mock.t.Fatalf("ITaskQueueMock.PauseQueue mock is already set by Expect")
+	}
+
+	if mmPauseQueue.defaultExpectation.paramPtrs == nil {
+		mmPauseQueue.defaultExpectation.paramPtrs = &ITaskQueueMockPauseQueueParamPtrs{}
+	}
+	mmPauseQueue.defaultExpectation.paramPtrs.shard = &shard
+	mmPauseQueue.defaultExpectation.expectationOrigins.originShard = minimock.CallerInfo(1)
+
+	return mmPauseQueue
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.PauseQueue
+func (mmPauseQueue *mITaskQueueMockPauseQueue) Inspect(f func(ctx context.Context, name string, shard int)) *mITaskQueueMockPauseQueue {
+	if mmPauseQueue.mock.inspectFuncPauseQueue != nil {
+		mmPauseQueue.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.PauseQueue")
+	}
+
+	mmPauseQueue.mock.inspectFuncPauseQueue = f
+
+	return mmPauseQueue
+}
+
+// Return sets up results that will be returned by ITaskQueue.PauseQueue
+func (mmPauseQueue *mITaskQueueMockPauseQueue) Return(qp1 *mm_queue.QueueStatus, err error) *ITaskQueueMock {
+	if mmPauseQueue.mock.funcPauseQueue != nil {
+		mmPauseQueue.mock.t.Fatalf("ITaskQueueMock.PauseQueue mock is already set by Set")
+	}
+
+	if mmPauseQueue.defaultExpectation == nil {
+		mmPauseQueue.defaultExpectation = &ITaskQueueMockPauseQueueExpectation{mock: mmPauseQueue.mock}
+	}
+	mmPauseQueue.defaultExpectation.results = &ITaskQueueMockPauseQueueResults{qp1, err}
+	mmPauseQueue.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPauseQueue.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.PauseQueue method
+func (mmPauseQueue *mITaskQueueMockPauseQueue) Set(f func(ctx context.Context, name string, shard int) (qp1 *mm_queue.QueueStatus, err error)) *ITaskQueueMock {
+	if mmPauseQueue.defaultExpectation != nil {
+		mmPauseQueue.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.PauseQueue method")
+	}
+
+	if len(mmPauseQueue.expectations) > 0 {
+		mmPauseQueue.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.PauseQueue method")
+	}
+
+	mmPauseQueue.mock.funcPauseQueue = f
+	mmPauseQueue.mock.funcPauseQueueOrigin = minimock.CallerInfo(1)
+	return mmPauseQueue.mock
+}
+
+// When sets expectation for the ITaskQueue.PauseQueue which will trigger the result defined by the following
+// Then helper
+func (mmPauseQueue *mITaskQueueMockPauseQueue) When(ctx context.Context, name string, shard int) *ITaskQueueMockPauseQueueExpectation {
+	if mmPauseQueue.mock.funcPauseQueue != nil {
+		mmPauseQueue.mock.t.Fatalf("ITaskQueueMock.PauseQueue mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockPauseQueueExpectation{
+		mock:               mmPauseQueue.mock,
+		params:             &ITaskQueueMockPauseQueueParams{ctx, name, shard},
+		expectationOrigins: ITaskQueueMockPauseQueueExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPauseQueue.expectations = append(mmPauseQueue.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.PauseQueue return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockPauseQueueExpectation) Then(qp1 *mm_queue.QueueStatus, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockPauseQueueResults{qp1, err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.PauseQueue should be invoked
+func (mmPauseQueue *mITaskQueueMockPauseQueue) Times(n uint64) *mITaskQueueMockPauseQueue {
+	if n == 0 {
+		mmPauseQueue.mock.t.Fatalf("Times of ITaskQueueMock.PauseQueue mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPauseQueue.expectedInvocations, n)
+	mmPauseQueue.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPauseQueue
+}
+
+func (mmPauseQueue *mITaskQueueMockPauseQueue) invocationsDone() bool {
+	if len(mmPauseQueue.expectations) == 0 && mmPauseQueue.defaultExpectation == nil && mmPauseQueue.mock.funcPauseQueue == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPauseQueue.mock.afterPauseQueueCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPauseQueue.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PauseQueue implements ITaskQueue
+func (mmPauseQueue *ITaskQueueMock) PauseQueue(ctx context.Context, name string, shard int) (qp1 *mm_queue.QueueStatus, err error) {
+	mm_atomic.AddUint64(&mmPauseQueue.beforePauseQueueCounter, 1)
+	defer mm_atomic.AddUint64(&mmPauseQueue.afterPauseQueueCounter, 1)
+
+	mmPauseQueue.t.Helper()
+
+	if mmPauseQueue.inspectFuncPauseQueue != nil {
+		mmPauseQueue.inspectFuncPauseQueue(ctx, name, shard)
+	}
+
+	mm_params := ITaskQueueMockPauseQueueParams{ctx, name, shard}
+
+	// Record call args
+	mmPauseQueue.PauseQueueMock.mutex.Lock()
+	mmPauseQueue.PauseQueueMock.callArgs = append(mmPauseQueue.PauseQueueMock.callArgs, &mm_params)
+	mmPauseQueue.PauseQueueMock.mutex.Unlock()
+
+	for _, e := range mmPauseQueue.PauseQueueMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.qp1, e.results.err
+		}
+	}
+
+	if mmPauseQueue.PauseQueueMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPauseQueue.PauseQueueMock.defaultExpectation.Counter, 1)
+		mm_want := mmPauseQueue.PauseQueueMock.defaultExpectation.params
+		mm_want_ptrs := mmPauseQueue.PauseQueueMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockPauseQueueParams{ctx, name, shard}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPauseQueue.t.Errorf("ITaskQueueMock.PauseQueue got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPauseQueue.PauseQueueMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmPauseQueue.t.Errorf("ITaskQueueMock.PauseQueue got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPauseQueue.PauseQueueMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+			if mm_want_ptrs.shard != nil && !minimock.Equal(*mm_want_ptrs.shard, mm_got.shard) {
+				mmPauseQueue.t.Errorf("ITaskQueueMock.PauseQueue got unexpected parameter shard, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPauseQueue.PauseQueueMock.defaultExpectation.expectationOrigins.originShard, *mm_want_ptrs.shard, mm_got.shard, minimock.Diff(*mm_want_ptrs.shard, mm_got.shard))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPauseQueue.t.Errorf("ITaskQueueMock.PauseQueue got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPauseQueue.PauseQueueMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPauseQueue.PauseQueueMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPauseQueue.t.Fatal("No results are set for the ITaskQueueMock.PauseQueue")
+		}
+		return (*mm_results).qp1, (*mm_results).err
+	}
+	if mmPauseQueue.funcPauseQueue != nil {
+		return mmPauseQueue.funcPauseQueue(ctx, name, shard)
+	}
+	mmPauseQueue.t.Fatalf("Unexpected call to ITaskQueueMock.PauseQueue. %v %v %v", ctx, name, shard)
+	return
+}
+
+// PauseQueueAfterCounter returns a count of finished ITaskQueueMock.PauseQueue invocations
+func (mmPauseQueue *ITaskQueueMock) PauseQueueAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPauseQueue.afterPauseQueueCounter)
+}
+
+// PauseQueueBeforeCounter returns a count of ITaskQueueMock.PauseQueue invocations
+func (mmPauseQueue *ITaskQueueMock) PauseQueueBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPauseQueue.beforePauseQueueCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.PauseQueue.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPauseQueue *mITaskQueueMockPauseQueue) Calls() []*ITaskQueueMockPauseQueueParams {
+	mmPauseQueue.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockPauseQueueParams, len(mmPauseQueue.callArgs))
+	copy(argCopy, mmPauseQueue.callArgs)
+
+	mmPauseQueue.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPauseQueueDone returns true if the count of the PauseQueue invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockPauseQueueDone() bool {
+	if m.PauseQueueMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PauseQueueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PauseQueueMock.invocationsDone()
+}
+
+// MinimockPauseQueueInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockPauseQueueInspect() {
+	for _, e := range m.PauseQueueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.PauseQueue at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPauseQueueCounter := mm_atomic.LoadUint64(&m.afterPauseQueueCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PauseQueueMock.defaultExpectation != nil && afterPauseQueueCounter < 1 {
+		if m.PauseQueueMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.PauseQueue at\n%s", m.PauseQueueMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.PauseQueue at\n%s with params: %#v", m.PauseQueueMock.defaultExpectation.expectationOrigins.origin, *m.PauseQueueMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPauseQueue != nil && afterPauseQueueCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.PauseQueue at\n%s", m.funcPauseQueueOrigin)
+	}
+
+	if !m.PauseQueueMock.invocationsDone() && afterPauseQueueCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.PauseQueue at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PauseQueueMock.expectedInvocations), m.PauseQueueMock.expectedInvocationsOrigin, afterPauseQueueCounter)
+	}
+}
+
+type mITaskQueueMockProcessTasks struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockProcessTasksExpectation
+	expectations       []*ITaskQueueMockProcessTasksExpectation
+
+	callArgs []*ITaskQueueMockProcessTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockProcessTasksExpectation specifies expectation struct of the ITaskQueue.ProcessTasks
+type ITaskQueueMockProcessTasksExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockProcessTasksParams
+	paramPtrs          *ITaskQueueMockProcessTasksParamPtrs
+	expectationOrigins ITaskQueueMockProcessTasksExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// ITaskQueueMockProcessTasksParams contains parameters of the ITaskQueue.ProcessTasks
+type ITaskQueueMockProcessTasksParams struct {
+	ctx context.Context
+}
+
+// ITaskQueueMockProcessTasksParamPtrs contains pointers to parameters of the ITaskQueue.ProcessTasks
+type ITaskQueueMockProcessTasksParamPtrs struct {
+	ctx *context.Context
+}
+
+// ITaskQueueMockProcessTasksOrigins contains origins of expectations of the ITaskQueue.ProcessTasks
+type ITaskQueueMockProcessTasksExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmProcessTasks *mITaskQueueMockProcessTasks) Optional() *mITaskQueueMockProcessTasks {
+	mmProcessTasks.optional = true
+	return mmProcessTasks
+}
+
+// Expect sets up expected params for ITaskQueue.ProcessTasks
+func (mmProcessTasks *mITaskQueueMockProcessTasks) Expect(ctx context.Context) *mITaskQueueMockProcessTasks {
+	if mmProcessTasks.mock.funcProcessTasks != nil {
+		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by Set")
+	}
+
+	if mmProcessTasks.defaultExpectation == nil {
+		mmProcessTasks.defaultExpectation = &ITaskQueueMockProcessTasksExpectation{}
+	}
+
+	if mmProcessTasks.defaultExpectation.paramPtrs != nil {
+		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by ExpectParams functions")
+	}
+
+	mmProcessTasks.defaultExpectation.params = &ITaskQueueMockProcessTasksParams{ctx}
+	mmProcessTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmProcessTasks.expectations {
+		if minimock.Equal(e.params, mmProcessTasks.defaultExpectation.params) {
+			mmProcessTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmProcessTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmProcessTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.ProcessTasks
+func (mmProcessTasks *mITaskQueueMockProcessTasks) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockProcessTasks {
+	if mmProcessTasks.mock.funcProcessTasks != nil {
+		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by Set")
+	}
+
+	if mmProcessTasks.defaultExpectation == nil {
+		mmProcessTasks.defaultExpectation = &ITaskQueueMockProcessTasksExpectation{}
+	}
+
+	if mmProcessTasks.defaultExpectation.params != nil {
+		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by Expect")
+	}
+
+	if mmProcessTasks.defaultExpectation.paramPtrs == nil {
+		mmProcessTasks.defaultExpectation.paramPtrs = &ITaskQueueMockProcessTasksParamPtrs{}
+	}
+	mmProcessTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmProcessTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmProcessTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.ProcessTasks
+func (mmProcessTasks *mITaskQueueMockProcessTasks) Inspect(f func(ctx context.Context)) *mITaskQueueMockProcessTasks {
+	if mmProcessTasks.mock.inspectFuncProcessTasks != nil {
+		mmProcessTasks.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.ProcessTasks")
+	}
+
+	mmProcessTasks.mock.inspectFuncProcessTasks = f
+
+	return mmProcessTasks
+}
+
+// Return sets up results that will be returned by ITaskQueue.ProcessTasks
+func (mmProcessTasks *mITaskQueueMockProcessTasks) Return() *ITaskQueueMock {
+	if mmProcessTasks.mock.funcProcessTasks != nil {
+		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by Set")
+	}
+
+	if mmProcessTasks.defaultExpectation == nil {
+		mmProcessTasks.defaultExpectation = &ITaskQueueMockProcessTasksExpectation{mock: mmProcessTasks.mock}
+	}
+
+	mmProcessTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmProcessTasks.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.ProcessTasks method
+func (mmProcessTasks *mITaskQueueMockProcessTasks) Set(f func(ctx context.Context)) *ITaskQueueMock {
+	if mmProcessTasks.defaultExpectation != nil {
+		mmProcessTasks.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.ProcessTasks method")
+	}
+
+	if len(mmProcessTasks.expectations) > 0 {
+		mmProcessTasks.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.ProcessTasks method")
+	}
+
+	mmProcessTasks.mock.funcProcessTasks = f
+	mmProcessTasks.mock.funcProcessTasksOrigin = minimock.CallerInfo(1)
+	return mmProcessTasks.mock
+}
+
+// When sets expectation for the ITaskQueue.ProcessTasks which will trigger the result defined by the following
+// Then helper
+func (mmProcessTasks *mITaskQueueMockProcessTasks) When(ctx context.Context) *ITaskQueueMockProcessTasksExpectation {
+	if mmProcessTasks.mock.funcProcessTasks != nil {
+		mmProcessTasks.mock.t.Fatalf("ITaskQueueMock.ProcessTasks mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockProcessTasksExpectation{
+		mock:               mmProcessTasks.mock,
+		params:             &ITaskQueueMockProcessTasksParams{ctx},
+		expectationOrigins: ITaskQueueMockProcessTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmProcessTasks.expectations = append(mmProcessTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.ProcessTasks return parameters for the expectation previously defined by the When method
+
+func (e *ITaskQueueMockProcessTasksExpectation) Then() *ITaskQueueMock {
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.ProcessTasks should be invoked
+func (mmProcessTasks *mITaskQueueMockProcessTasks) Times(n uint64) *mITaskQueueMockProcessTasks {
+	if n == 0 {
+		mmProcessTasks.mock.t.Fatalf("Times of ITaskQueueMock.ProcessTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmProcessTasks.expectedInvocations, n)
+	mmProcessTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmProcessTasks
+}
+
+func (mmProcessTasks *mITaskQueueMockProcessTasks) invocationsDone() bool {
+	if len(mmProcessTasks.expectations) == 0 && mmProcessTasks.defaultExpectation == nil && mmProcessTasks.mock.funcProcessTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmProcessTasks.mock.afterProcessTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmProcessTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ProcessTasks implements ITaskQueue
+func (mmProcessTasks *ITaskQueueMock) ProcessTasks(ctx context.Context) {
+	mm_atomic.AddUint64(&mmProcessTasks.beforeProcessTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmProcessTasks.afterProcessTasksCounter, 1)
+
+	mmProcessTasks.t.Helper()
+
+	if mmProcessTasks.inspectFuncProcessTasks != nil {
+		mmProcessTasks.inspectFuncProcessTasks(ctx)
+	}
+
+	mm_params := ITaskQueueMockProcessTasksParams{ctx}
+
+	// Record call args
+	mmProcessTasks.ProcessTasksMock.mutex.Lock()
+	mmProcessTasks.ProcessTasksMock.callArgs = append(mmProcessTasks.ProcessTasksMock.callArgs, &mm_params)
+	mmProcessTasks.ProcessTasksMock.mutex.Unlock()
+
+	for _, e := range mmProcessTasks.ProcessTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmProcessTasks.ProcessTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmProcessTasks.ProcessTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmProcessTasks.ProcessTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmProcessTasks.ProcessTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockProcessTasksParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmProcessTasks.t.Errorf("ITaskQueueMock.ProcessTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmProcessTasks.ProcessTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmProcessTasks.t.Errorf("ITaskQueueMock.ProcessTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmProcessTasks.ProcessTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmProcessTasks.funcProcessTasks != nil {
+		mmProcessTasks.funcProcessTasks(ctx)
+		return
+	}
+	mmProcessTasks.t.Fatalf("Unexpected call to ITaskQueueMock.ProcessTasks. %v", ctx)
+
+}
+
+// ProcessTasksAfterCounter returns a count of finished ITaskQueueMock.ProcessTasks invocations
+func (mmProcessTasks *ITaskQueueMock) ProcessTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProcessTasks.afterProcessTasksCounter)
+}
+
+// ProcessTasksBeforeCounter returns a count of ITaskQueueMock.ProcessTasks invocations
+func (mmProcessTasks *ITaskQueueMock) ProcessTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmProcessTasks.beforeProcessTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.ProcessTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmProcessTasks *mITaskQueueMockProcessTasks) Calls() []*ITaskQueueMockProcessTasksParams {
+	mmProcessTasks.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockProcessTasksParams, len(mmProcessTasks.callArgs))
+	copy(argCopy, mmProcessTasks.callArgs)
+
+	mmProcessTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockProcessTasksDone returns true if the count of the ProcessTasks invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockProcessTasksDone() bool {
+	if m.ProcessTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ProcessTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ProcessTasksMock.invocationsDone()
+}
+
+// MinimockProcessTasksInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockProcessTasksInspect() {
+	for _, e := range m.ProcessTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.ProcessTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterProcessTasksCounter := mm_atomic.LoadUint64(&m.afterProcessTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ProcessTasksMock.defaultExpectation != nil && afterProcessTasksCounter < 1 {
+		if m.ProcessTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.ProcessTasks at\n%s", m.ProcessTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.ProcessTasks at\n%s with params: %#v", m.ProcessTasksMock.defaultExpectation.expectationOrigins.origin, *m.ProcessTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcProcessTasks != nil && afterProcessTasksCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.ProcessTasks at\n%s", m.funcProcessTasksOrigin)
+	}
+
+	if !m.ProcessTasksMock.invocationsDone() && afterProcessTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.ProcessTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ProcessTasksMock.expectedInvocations), m.ProcessTasksMock.expectedInvocationsOrigin, afterProcessTasksCounter)
+	}
+}
+
+type mITaskQueueMockPurgeDeadTask struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockPurgeDeadTaskExpectation
+	expectations       []*ITaskQueueMockPurgeDeadTaskExpectation
+
+	callArgs []*ITaskQueueMockPurgeDeadTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockPurgeDeadTaskExpectation specifies expectation struct of the ITaskQueue.PurgeDeadTask
+type ITaskQueueMockPurgeDeadTaskExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockPurgeDeadTaskParams
+	paramPtrs          *ITaskQueueMockPurgeDeadTaskParamPtrs
+	expectationOrigins ITaskQueueMockPurgeDeadTaskExpectationOrigins
+	results            *ITaskQueueMockPurgeDeadTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockPurgeDeadTaskParams contains parameters of the ITaskQueue.PurgeDeadTask
+type ITaskQueueMockPurgeDeadTaskParams struct {
+	ctx    context.Context
+	taskID string
+}
+
+// ITaskQueueMockPurgeDeadTaskParamPtrs contains pointers to parameters of the ITaskQueue.PurgeDeadTask
+type ITaskQueueMockPurgeDeadTaskParamPtrs struct {
+	ctx    *context.Context
+	taskID *string
+}
+
+// ITaskQueueMockPurgeDeadTaskResults contains results of the ITaskQueue.PurgeDeadTask
+type ITaskQueueMockPurgeDeadTaskResults struct {
+	err error
+}
+
+// ITaskQueueMockPurgeDeadTaskOrigins contains origins of expectations of the ITaskQueue.PurgeDeadTask
+type ITaskQueueMockPurgeDeadTaskExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeDeadTask *mITaskQueueMockPurgeDeadTask) Optional() *mITaskQueueMockPurgeDeadTask {
+	mmPurgeDeadTask.optional = true
+	return mmPurgeDeadTask
+}
+
+// Expect sets up expected params for ITaskQueue.PurgeDeadTask
+func (mmPurgeDeadTask *mITaskQueueMockPurgeDeadTask) Expect(ctx context.Context, taskID string) *mITaskQueueMockPurgeDeadTask {
+	if mmPurgeDeadTask.mock.funcPurgeDeadTask != nil {
+		mmPurgeDeadTask.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTask mock is already set by Set")
+	}
+
+	if mmPurgeDeadTask.defaultExpectation == nil {
+		mmPurgeDeadTask.defaultExpectation = &ITaskQueueMockPurgeDeadTaskExpectation{}
+	}
+
+	if mmPurgeDeadTask.defaultExpectation.paramPtrs != nil {
+		mmPurgeDeadTask.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTask mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeDeadTask.defaultExpectation.params = &ITaskQueueMockPurgeDeadTaskParams{ctx, taskID}
+	mmPurgeDeadTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeDeadTask.expectations {
+		if minimock.Equal(e.params, mmPurgeDeadTask.defaultExpectation.params) {
+			mmPurgeDeadTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeadTask.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeDeadTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.PurgeDeadTask
+func (mmPurgeDeadTask *mITaskQueueMockPurgeDeadTask) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockPurgeDeadTask {
+	if mmPurgeDeadTask.mock.funcPurgeDeadTask != nil {
+		mmPurgeDeadTask.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTask mock is already set by Set")
+	}
+
+	if mmPurgeDeadTask.defaultExpectation == nil {
+		mmPurgeDeadTask.defaultExpectation = &ITaskQueueMockPurgeDeadTaskExpectation{}
+	}
+
+	if mmPurgeDeadTask.defaultExpectation.params != nil {
+		mmPurgeDeadTask.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTask mock is already set by Expect")
+	}
+
+	if mmPurgeDeadTask.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeadTask.defaultExpectation.paramPtrs = &ITaskQueueMockPurgeDeadTaskParamPtrs{}
+	}
+	mmPurgeDeadTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeDeadTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeDeadTask
+}
+
+// ExpectTaskIDParam2 sets up expected param taskID for ITaskQueue.PurgeDeadTask
+func (mmPurgeDeadTask *mITaskQueueMockPurgeDeadTask) ExpectTaskIDParam2(taskID string) *mITaskQueueMockPurgeDeadTask {
+	if mmPurgeDeadTask.mock.funcPurgeDeadTask != nil {
+		mmPurgeDeadTask.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTask mock is already set by Set")
+	}
+
+	if mmPurgeDeadTask.defaultExpectation == nil {
+		mmPurgeDeadTask.defaultExpectation = &ITaskQueueMockPurgeDeadTaskExpectation{}
+	}
+
+	if mmPurgeDeadTask.defaultExpectation.params != nil {
+		mmPurgeDeadTask.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTask mock is already set by Expect")
+	}
+
+	if mmPurgeDeadTask.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeadTask.defaultExpectation.paramPtrs = &ITaskQueueMockPurgeDeadTaskParamPtrs{}
+	}
+	mmPurgeDeadTask.defaultExpectation.paramPtrs.taskID = &taskID
+	mmPurgeDeadTask.defaultExpectation.expectationOrigins.originTaskID = minimock.CallerInfo(1)
+
+	return mmPurgeDeadTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.PurgeDeadTask
+func (mmPurgeDeadTask *mITaskQueueMockPurgeDeadTask) Inspect(f func(ctx context.Context, taskID string)) *mITaskQueueMockPurgeDeadTask {
+	if mmPurgeDeadTask.mock.inspectFuncPurgeDeadTask != nil {
+		mmPurgeDeadTask.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.PurgeDeadTask")
+	}
+
+	mmPurgeDeadTask.mock.inspectFuncPurgeDeadTask = f
+
+	return mmPurgeDeadTask
+}
+
+// Return sets up results that will be returned by ITaskQueue.PurgeDeadTask
+func (mmPurgeDeadTask *mITaskQueueMockPurgeDeadTask) Return(err error) *ITaskQueueMock {
+	if mmPurgeDeadTask.mock.funcPurgeDeadTask != nil {
+		mmPurgeDeadTask.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTask mock is already set by Set")
+	}
+
+	if mmPurgeDeadTask.defaultExpectation == nil {
+		mmPurgeDeadTask.defaultExpectation = &ITaskQueueMockPurgeDeadTaskExpectation{mock: mmPurgeDeadTask.mock}
+	}
+	mmPurgeDeadTask.defaultExpectation.results = &ITaskQueueMockPurgeDeadTaskResults{err}
+	mmPurgeDeadTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeadTask.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.PurgeDeadTask method
+func (mmPurgeDeadTask *mITaskQueueMockPurgeDeadTask) Set(f func(ctx context.Context, taskID string) (err error)) *ITaskQueueMock {
+	if mmPurgeDeadTask.defaultExpectation != nil {
+		mmPurgeDeadTask.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.PurgeDeadTask method")
+	}
+
+	if len(mmPurgeDeadTask.expectations) > 0 {
+		mmPurgeDeadTask.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.PurgeDeadTask method")
+	}
+
+	mmPurgeDeadTask.mock.funcPurgeDeadTask = f
+	mmPurgeDeadTask.mock.funcPurgeDeadTaskOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeadTask.mock
+}
+
+// When sets expectation for the ITaskQueue.PurgeDeadTask which will trigger the result defined by the following
+// Then helper
+func (mmPurgeDeadTask *mITaskQueueMockPurgeDeadTask) When(ctx context.Context, taskID string) *ITaskQueueMockPurgeDeadTaskExpectation {
+	if mmPurgeDeadTask.mock.funcPurgeDeadTask != nil {
+		mmPurgeDeadTask.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTask mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockPurgeDeadTaskExpectation{
+		mock:               mmPurgeDeadTask.mock,
+		params:             &ITaskQueueMockPurgeDeadTaskParams{ctx, taskID},
+		expectationOrigins: ITaskQueueMockPurgeDeadTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeDeadTask.expectations = append(mmPurgeDeadTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.PurgeDeadTask return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockPurgeDeadTaskExpectation) Then(err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockPurgeDeadTaskResults{err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.PurgeDeadTask should be invoked
+func (mmPurgeDeadTask *mITaskQueueMockPurgeDeadTask) Times(n uint64) *mITaskQueueMockPurgeDeadTask {
+	if n == 0 {
+		mmPurgeDeadTask.mock.t.Fatalf("Times of ITaskQueueMock.PurgeDeadTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeDeadTask.expectedInvocations, n)
+	mmPurgeDeadTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeadTask
+}
+
+func (mmPurgeDeadTask *mITaskQueueMockPurgeDeadTask) invocationsDone() bool {
+	if len(mmPurgeDeadTask.expectations) == 0 && mmPurgeDeadTask.defaultExpectation == nil && mmPurgeDeadTask.mock.funcPurgeDeadTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeDeadTask.mock.afterPurgeDeadTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeDeadTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeDeadTask implements ITaskQueue
+func (mmPurgeDeadTask *ITaskQueueMock) PurgeDeadTask(ctx context.Context, taskID string) (err error) {
+	mm_atomic.AddUint64(&mmPurgeDeadTask.beforePurgeDeadTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeadTask.afterPurgeDeadTaskCounter, 1)
+
+	mmPurgeDeadTask.t.Helper()
+
+	if mmPurgeDeadTask.inspectFuncPurgeDeadTask != nil {
+		mmPurgeDeadTask.inspectFuncPurgeDeadTask(ctx, taskID)
+	}
+
+	mm_params := ITaskQueueMockPurgeDeadTaskParams{ctx, taskID}
+
+	// Record call args
+	mmPurgeDeadTask.PurgeDeadTaskMock.mutex.Lock()
+	mmPurgeDeadTask.PurgeDeadTaskMock.callArgs = append(mmPurgeDeadTask.PurgeDeadTaskMock.callArgs, &mm_params)
+	mmPurgeDeadTask.PurgeDeadTaskMock.mutex.Unlock()
+
+	for _, e := range mmPurgeDeadTask.PurgeDeadTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPurgeDeadTask.PurgeDeadTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeadTask.PurgeDeadTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeadTask.PurgeDeadTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeDeadTask.PurgeDeadTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockPurgeDeadTaskParams{ctx, taskID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeDeadTask.t.Errorf("ITaskQueueMock.PurgeDeadTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeadTask.PurgeDeadTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskID != nil && !minimock.Equal(*mm_want_ptrs.taskID, mm_got.taskID) {
+				mmPurgeDeadTask.t.Errorf("ITaskQueueMock.PurgeDeadTask got unexpected parameter taskID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeadTask.PurgeDeadTaskMock.defaultExpectation.expectationOrigins.originTaskID, *mm_want_ptrs.taskID, mm_got.taskID, minimock.Diff(*mm_want_ptrs.taskID, mm_got.taskID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeDeadTask.t.Errorf("ITaskQueueMock.PurgeDeadTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeDeadTask.PurgeDeadTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeDeadTask.PurgeDeadTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeDeadTask.t.Fatal("No results are set for the ITaskQueueMock.PurgeDeadTask")
+		}
+		return (*mm_results).err
+	}
+	if mmPurgeDeadTask.funcPurgeDeadTask != nil {
+		return mmPurgeDeadTask.funcPurgeDeadTask(ctx, taskID)
+	}
+	mmPurgeDeadTask.t.Fatalf("Unexpected call to ITaskQueueMock.PurgeDeadTask. %v %v", ctx, taskID)
+	return
+}
+
+// PurgeDeadTaskAfterCounter returns a count of finished ITaskQueueMock.PurgeDeadTask invocations
+func (mmPurgeDeadTask *ITaskQueueMock) PurgeDeadTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeadTask.afterPurgeDeadTaskCounter)
+}
+
+// PurgeDeadTaskBeforeCounter returns a count of ITaskQueueMock.PurgeDeadTask invocations
+func (mmPurgeDeadTask *ITaskQueueMock) PurgeDeadTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeadTask.beforePurgeDeadTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.PurgeDeadTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeDeadTask *mITaskQueueMockPurgeDeadTask) Calls() []*ITaskQueueMockPurgeDeadTaskParams {
+	mmPurgeDeadTask.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockPurgeDeadTaskParams, len(mmPurgeDeadTask.callArgs))
+	copy(argCopy, mmPurgeDeadTask.callArgs)
+
+	mmPurgeDeadTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDeadTaskDone returns true if the count of the PurgeDeadTask invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockPurgeDeadTaskDone() bool {
+	if m.PurgeDeadTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeDeadTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeDeadTaskMock.invocationsDone()
+}
+
+// MinimockPurgeDeadTaskInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockPurgeDeadTaskInspect() {
+	for _, e := range m.PurgeDeadTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.PurgeDeadTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeDeadTaskCounter := mm_atomic.LoadUint64(&m.afterPurgeDeadTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeadTaskMock.defaultExpectation != nil && afterPurgeDeadTaskCounter < 1 {
+		if m.PurgeDeadTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.PurgeDeadTask at\n%s", m.PurgeDeadTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.PurgeDeadTask at\n%s with params: %#v", m.PurgeDeadTaskMock.defaultExpectation.expectationOrigins.origin, *m.PurgeDeadTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeadTask != nil && afterPurgeDeadTaskCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.PurgeDeadTask at\n%s", m.funcPurgeDeadTaskOrigin)
+	}
+
+	if !m.PurgeDeadTaskMock.invocationsDone() && afterPurgeDeadTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.PurgeDeadTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeDeadTaskMock.expectedInvocations), m.PurgeDeadTaskMock.expectedInvocationsOrigin, afterPurgeDeadTaskCounter)
+	}
+}
+
+type mITaskQueueMockPurgeDeadTasks struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockPurgeDeadTasksExpectation
+	expectations       []*ITaskQueueMockPurgeDeadTasksExpectation
+
+	callArgs []*ITaskQueueMockPurgeDeadTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockPurgeDeadTasksExpectation specifies expectation struct of the ITaskQueue.PurgeDeadTasks
+type ITaskQueueMockPurgeDeadTasksExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockPurgeDeadTasksParams
+	paramPtrs          *ITaskQueueMockPurgeDeadTasksParamPtrs
+	expectationOrigins ITaskQueueMockPurgeDeadTasksExpectationOrigins
+	results            *ITaskQueueMockPurgeDeadTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockPurgeDeadTasksParams contains parameters of the ITaskQueue.PurgeDeadTasks
+type ITaskQueueMockPurgeDeadTasksParams struct {
+	ctx context.Context
+}
+
+// ITaskQueueMockPurgeDeadTasksParamPtrs contains pointers to parameters of the ITaskQueue.PurgeDeadTasks
+type ITaskQueueMockPurgeDeadTasksParamPtrs struct {
+	ctx *context.Context
+}
+
+// ITaskQueueMockPurgeDeadTasksResults contains results of the ITaskQueue.PurgeDeadTasks
+type ITaskQueueMockPurgeDeadTasksResults struct {
+	i1  int64
+	err error
+}
+
+// ITaskQueueMockPurgeDeadTasksOrigins contains origins of expectations of the ITaskQueue.PurgeDeadTasks
+type ITaskQueueMockPurgeDeadTasksExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeDeadTasks *mITaskQueueMockPurgeDeadTasks) Optional() *mITaskQueueMockPurgeDeadTasks {
+	mmPurgeDeadTasks.optional = true
+	return mmPurgeDeadTasks
+}
+
+// Expect sets up expected params for ITaskQueue.PurgeDeadTasks
+func (mmPurgeDeadTasks *mITaskQueueMockPurgeDeadTasks) Expect(ctx context.Context) *mITaskQueueMockPurgeDeadTasks {
+	if mmPurgeDeadTasks.mock.funcPurgeDeadTasks != nil {
+		mmPurgeDeadTasks.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTasks mock is already set by Set")
+	}
+
+	if mmPurgeDeadTasks.defaultExpectation == nil {
+		mmPurgeDeadTasks.defaultExpectation = &ITaskQueueMockPurgeDeadTasksExpectation{}
+	}
+
+	if mmPurgeDeadTasks.defaultExpectation.paramPtrs != nil {
+		mmPurgeDeadTasks.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTasks mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeDeadTasks.defaultExpectation.params = &ITaskQueueMockPurgeDeadTasksParams{ctx}
+	mmPurgeDeadTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeDeadTasks.expectations {
+		if minimock.Equal(e.params, mmPurgeDeadTasks.defaultExpectation.params) {
+			mmPurgeDeadTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeadTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeDeadTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.PurgeDeadTasks
+func (mmPurgeDeadTasks *mITaskQueueMockPurgeDeadTasks) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockPurgeDeadTasks {
+	if mmPurgeDeadTasks.mock.funcPurgeDeadTasks != nil {
+		mmPurgeDeadTasks.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTasks mock is already set by Set")
+	}
+
+	if mmPurgeDeadTasks.defaultExpectation == nil {
+		mmPurgeDeadTasks.defaultExpectation = &ITaskQueueMockPurgeDeadTasksExpectation{}
+	}
+
+	if mmPurgeDeadTasks.defaultExpectation.params != nil {
+		mmPurgeDeadTasks.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTasks mock is already set by Expect")
+	}
+
+	if mmPurgeDeadTasks.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeadTasks.defaultExpectation.paramPtrs = &ITaskQueueMockPurgeDeadTasksParamPtrs{}
+	}
+	mmPurgeDeadTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeDeadTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeDeadTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.PurgeDeadTasks
+func (mmPurgeDeadTasks *mITaskQueueMockPurgeDeadTasks) Inspect(f func(ctx context.Context)) *mITaskQueueMockPurgeDeadTasks {
+	if mmPurgeDeadTasks.mock.inspectFuncPurgeDeadTasks != nil {
+		mmPurgeDeadTasks.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.PurgeDeadTasks")
+	}
+
+	mmPurgeDeadTasks.mock.inspectFuncPurgeDeadTasks = f
+
+	return mmPurgeDeadTasks
+}
+
+// Return sets up results that will be returned by ITaskQueue.PurgeDeadTasks
+func (mmPurgeDeadTasks *mITaskQueueMockPurgeDeadTasks) Return(i1 int64, err error) *ITaskQueueMock {
+	if mmPurgeDeadTasks.mock.funcPurgeDeadTasks != nil {
+		mmPurgeDeadTasks.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTasks mock is already set by Set")
+	}
+
+	if mmPurgeDeadTasks.defaultExpectation == nil {
+		mmPurgeDeadTasks.defaultExpectation = &ITaskQueueMockPurgeDeadTasksExpectation{mock: mmPurgeDeadTasks.mock}
+	}
+	mmPurgeDeadTasks.defaultExpectation.results = &ITaskQueueMockPurgeDeadTasksResults{i1, err}
+	mmPurgeDeadTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeadTasks.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.PurgeDeadTasks method
+func (mmPurgeDeadTasks *mITaskQueueMockPurgeDeadTasks) Set(f func(ctx context.Context) (i1 int64, err error)) *ITaskQueueMock {
+	if mmPurgeDeadTasks.defaultExpectation != nil {
+		mmPurgeDeadTasks.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.PurgeDeadTasks method")
+	}
+
+	if len(mmPurgeDeadTasks.expectations) > 0 {
+		mmPurgeDeadTasks.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.PurgeDeadTasks method")
+	}
+
+	mmPurgeDeadTasks.mock.funcPurgeDeadTasks = f
+	mmPurgeDeadTasks.mock.funcPurgeDeadTasksOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeadTasks.mock
+}
+
+// When sets expectation for the ITaskQueue.PurgeDeadTasks which will trigger the result defined by the following
+// Then helper
+func (mmPurgeDeadTasks *mITaskQueueMockPurgeDeadTasks) When(ctx context.Context) *ITaskQueueMockPurgeDeadTasksExpectation {
+	if mmPurgeDeadTasks.mock.funcPurgeDeadTasks != nil {
+		mmPurgeDeadTasks.mock.t.Fatalf("ITaskQueueMock.PurgeDeadTasks mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockPurgeDeadTasksExpectation{
+		mock:               mmPurgeDeadTasks.mock,
+		params:             &ITaskQueueMockPurgeDeadTasksParams{ctx},
+		expectationOrigins: ITaskQueueMockPurgeDeadTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeDeadTasks.expectations = append(mmPurgeDeadTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.PurgeDeadTasks return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockPurgeDeadTasksExpectation) Then(i1 int64, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockPurgeDeadTasksResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.PurgeDeadTasks should be invoked
+func (mmPurgeDeadTasks *mITaskQueueMockPurgeDeadTasks) Times(n uint64) *mITaskQueueMockPurgeDeadTasks {
+	if n == 0 {
+		mmPurgeDeadTasks.mock.t.Fatalf("Times of ITaskQueueMock.PurgeDeadTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeDeadTasks.expectedInvocations, n)
+	mmPurgeDeadTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeadTasks
+}
+
+func (mmPurgeDeadTasks *mITaskQueueMockPurgeDeadTasks) invocationsDone() bool {
+	if len(mmPurgeDeadTasks.expectations) == 0 && mmPurgeDeadTasks.defaultExpectation == nil && mmPurgeDeadTasks.mock.funcPurgeDeadTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeDeadTasks.mock.afterPurgeDeadTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeDeadTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeDeadTasks implements ITaskQueue
+func (mmPurgeDeadTasks *ITaskQueueMock) PurgeDeadTasks(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeDeadTasks.beforePurgeDeadTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeadTasks.afterPurgeDeadTasksCounter, 1)
+
+	mmPurgeDeadTasks.t.Helper()
+
+	if mmPurgeDeadTasks.inspectFuncPurgeDeadTasks != nil {
+		mmPurgeDeadTasks.inspectFuncPurgeDeadTasks(ctx)
+	}
+
+	mm_params := ITaskQueueMockPurgeDeadTasksParams{ctx}
+
+	// Record call args
+	mmPurgeDeadTasks.PurgeDeadTasksMock.mutex.Lock()
+	mmPurgeDeadTasks.PurgeDeadTasksMock.callArgs = append(mmPurgeDeadTasks.PurgeDeadTasksMock.callArgs, &mm_params)
+	mmPurgeDeadTasks.PurgeDeadTasksMock.mutex.Unlock()
+
+	for _, e := range mmPurgeDeadTasks.PurgeDeadTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeDeadTasks.PurgeDeadTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeadTasks.PurgeDeadTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeadTasks.PurgeDeadTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeDeadTasks.PurgeDeadTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockPurgeDeadTasksParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeDeadTasks.t.Errorf("ITaskQueueMock.PurgeDeadTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeadTasks.PurgeDeadTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeDeadTasks.t.Errorf("ITaskQueueMock.PurgeDeadTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeDeadTasks.PurgeDeadTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeDeadTasks.PurgeDeadTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeDeadTasks.t.Fatal("No results are set for the ITaskQueueMock.PurgeDeadTasks")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeDeadTasks.funcPurgeDeadTasks != nil {
+		return mmPurgeDeadTasks.funcPurgeDeadTasks(ctx)
+	}
+	mmPurgeDeadTasks.t.Fatalf("Unexpected call to ITaskQueueMock.PurgeDeadTasks. %v", ctx)
+	return
+}
+
+// PurgeDeadTasksAfterCounter returns a count of finished ITaskQueueMock.PurgeDeadTasks invocations
+func (mmPurgeDeadTasks *ITaskQueueMock) PurgeDeadTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeadTasks.afterPurgeDeadTasksCounter)
+}
+
+// PurgeDeadTasksBeforeCounter returns a count of ITaskQueueMock.PurgeDeadTasks invocations
+func (mmPurgeDeadTasks *ITaskQueueMock) PurgeDeadTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeadTasks.beforePurgeDeadTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.PurgeDeadTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeDeadTasks *mITaskQueueMockPurgeDeadTasks) Calls() []*ITaskQueueMockPurgeDeadTasksParams {
+	mmPurgeDeadTasks.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockPurgeDeadTasksParams, len(mmPurgeDeadTasks.callArgs))
+	copy(argCopy, mmPurgeDeadTasks.callArgs)
+
+	mmPurgeDeadTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDeadTasksDone returns true if the count of the PurgeDeadTasks invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockPurgeDeadTasksDone() bool {
+	if m.PurgeDeadTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeDeadTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeDeadTasksMock.invocationsDone()
+}
+
+// MinimockPurgeDeadTasksInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockPurgeDeadTasksInspect() {
+	for _, e := range m.PurgeDeadTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.PurgeDeadTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeDeadTasksCounter := mm_atomic.LoadUint64(&m.afterPurgeDeadTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeadTasksMock.defaultExpectation != nil && afterPurgeDeadTasksCounter < 1 {
+		if m.PurgeDeadTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.PurgeDeadTasks at\n%s", m.PurgeDeadTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.PurgeDeadTasks at\n%s with params: %#v", m.PurgeDeadTasksMock.defaultExpectation.expectationOrigins.origin, *m.PurgeDeadTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeadTasks != nil && afterPurgeDeadTasksCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.PurgeDeadTasks at\n%s", m.funcPurgeDeadTasksOrigin)
+	}
+
+	if !m.PurgeDeadTasksMock.invocationsDone() && afterPurgeDeadTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.PurgeDeadTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeDeadTasksMock.expectedInvocations), m.PurgeDeadTasksMock.expectedInvocationsOrigin, afterPurgeDeadTasksCounter)
+	}
+}
+
+type mITaskQueueMockRequeueDeadTask struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockRequeueDeadTaskExpectation
+	expectations       []*ITaskQueueMockRequeueDeadTaskExpectation
+
+	callArgs []*ITaskQueueMockRequeueDeadTaskParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockRequeueDeadTaskExpectation specifies expectation struct of the ITaskQueue.RequeueDeadTask
+type ITaskQueueMockRequeueDeadTaskExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockRequeueDeadTaskParams
+	paramPtrs          *ITaskQueueMockRequeueDeadTaskParamPtrs
+	expectationOrigins ITaskQueueMockRequeueDeadTaskExpectationOrigins
+	results            *ITaskQueueMockRequeueDeadTaskResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockRequeueDeadTaskParams contains parameters of the ITaskQueue.RequeueDeadTask
+type ITaskQueueMockRequeueDeadTaskParams struct {
+	ctx    context.Context
+	taskID string
+}
+
+// ITaskQueueMockRequeueDeadTaskParamPtrs contains pointers to parameters of the ITaskQueue.RequeueDeadTask
+type ITaskQueueMockRequeueDeadTaskParamPtrs struct {
+	ctx    *context.Context
+	taskID *string
+}
+
+// ITaskQueueMockRequeueDeadTaskResults contains results of the ITaskQueue.RequeueDeadTask
+type ITaskQueueMockRequeueDeadTaskResults struct {
+	tp1 *mm_queue.TaskInfo
+	err error
+}
+
+// ITaskQueueMockRequeueDeadTaskOrigins contains origins of expectations of the ITaskQueue.RequeueDeadTask
+type ITaskQueueMockRequeueDeadTaskExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originTaskID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequeueDeadTask *mITaskQueueMockRequeueDeadTask) Optional() *mITaskQueueMockRequeueDeadTask {
+	mmRequeueDeadTask.optional = true
+	return mmRequeueDeadTask
+}
+
+// Expect sets up expected params for ITaskQueue.RequeueDeadTask
+func (mmRequeueDeadTask *mITaskQueueMockRequeueDeadTask) Expect(ctx context.Context, taskID string) *mITaskQueueMockRequeueDeadTask {
+	if mmRequeueDeadTask.mock.funcRequeueDeadTask != nil {
+		mmRequeueDeadTask.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTask mock is already set by Set")
+	}
+
+	if mmRequeueDeadTask.defaultExpectation == nil {
+		mmRequeueDeadTask.defaultExpectation = &ITaskQueueMockRequeueDeadTaskExpectation{}
+	}
+
+	if mmRequeueDeadTask.defaultExpectation.paramPtrs != nil {
+		mmRequeueDeadTask.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTask mock is already set by ExpectParams functions")
+	}
+
+	mmRequeueDeadTask.defaultExpectation.params = &ITaskQueueMockRequeueDeadTaskParams{ctx, taskID}
+	mmRequeueDeadTask.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequeueDeadTask.expectations {
+		if minimock.Equal(e.params, mmRequeueDeadTask.defaultExpectation.params) {
+			mmRequeueDeadTask.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequeueDeadTask.defaultExpectation.params)
+		}
+	}
+
+	return mmRequeueDeadTask
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.RequeueDeadTask
+func (mmRequeueDeadTask *mITaskQueueMockRequeueDeadTask) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockRequeueDeadTask {
+	if mmRequeueDeadTask.mock.funcRequeueDeadTask != nil {
+		mmRequeueDeadTask.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTask mock is already set by Set")
+	}
+
+	if mmRequeueDeadTask.defaultExpectation == nil {
+		mmRequeueDeadTask.defaultExpectation = &ITaskQueueMockRequeueDeadTaskExpectation{}
+	}
+
+	if mmRequeueDeadTask.defaultExpectation.params != nil {
+		mmRequeueDeadTask.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTask mock is already set by Expect")
+	}
+
+	if mmRequeueDeadTask.defaultExpectation.paramPtrs == nil {
+		mmRequeueDeadTask.defaultExpectation.paramPtrs = &ITaskQueueMockRequeueDeadTaskParamPtrs{}
+	}
+	mmRequeueDeadTask.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRequeueDeadTask.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRequeueDeadTask
+}
+
+// ExpectTaskIDParam2 sets up expected param taskID for ITaskQueue.RequeueDeadTask
+func (mmRequeueDeadTask *mITaskQueueMockRequeueDeadTask) ExpectTaskIDParam2(taskID string) *mITaskQueueMockRequeueDeadTask {
+	if mmRequeueDeadTask.mock.funcRequeueDeadTask != nil {
+		mmRequeueDeadTask.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTask mock is already set by Set")
+	}
+
+	if mmRequeueDeadTask.defaultExpectation == nil {
+		mmRequeueDeadTask.defaultExpectation = &ITaskQueueMockRequeueDeadTaskExpectation{}
+	}
+
+	if mmRequeueDeadTask.defaultExpectation.params != nil {
+		mmRequeueDeadTask.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTask mock is already set by Expect")
+	}
+
+	if mmRequeueDeadTask.defaultExpectation.paramPtrs == nil {
+		mmRequeueDeadTask.defaultExpectation.paramPtrs = &ITaskQueueMockRequeueDeadTaskParamPtrs{}
+	}
+	mmRequeueDeadTask.defaultExpectation.paramPtrs.taskID = &taskID
+	mmRequeueDeadTask.defaultExpectation.expectationOrigins.originTaskID = minimock.CallerInfo(1)
+
+	return mmRequeueDeadTask
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.RequeueDeadTask
+func (mmRequeueDeadTask *mITaskQueueMockRequeueDeadTask) Inspect(f func(ctx context.Context, taskID string)) *mITaskQueueMockRequeueDeadTask {
+	if mmRequeueDeadTask.mock.inspectFuncRequeueDeadTask != nil {
+		mmRequeueDeadTask.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.RequeueDeadTask")
+	}
+
+	mmRequeueDeadTask.mock.inspectFuncRequeueDeadTask = f
+
+	return mmRequeueDeadTask
+}
+
+// Return sets up results that will be returned by ITaskQueue.RequeueDeadTask
+func (mmRequeueDeadTask *mITaskQueueMockRequeueDeadTask) Return(tp1 *mm_queue.TaskInfo, err error) *ITaskQueueMock {
+	if mmRequeueDeadTask.mock.funcRequeueDeadTask != nil {
+		mmRequeueDeadTask.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTask mock is already set by Set")
+	}
+
+	if mmRequeueDeadTask.defaultExpectation == nil {
+		mmRequeueDeadTask.defaultExpectation = &ITaskQueueMockRequeueDeadTaskExpectation{mock: mmRequeueDeadTask.mock}
+	}
+	mmRequeueDeadTask.defaultExpectation.results = &ITaskQueueMockRequeueDeadTaskResults{tp1, err}
+	mmRequeueDeadTask.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequeueDeadTask.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.RequeueDeadTask method
+func (mmRequeueDeadTask *mITaskQueueMockRequeueDeadTask) Set(f func(ctx context.Context, taskID string) (tp1 *mm_queue.TaskInfo, err error)) *ITaskQueueMock {
+	if mmRequeueDeadTask.defaultExpectation != nil {
+		mmRequeueDeadTask.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.RequeueDeadTask method")
+	}
+
+	if len(mmRequeueDeadTask.expectations) > 0 {
+		mmRequeueDeadTask.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.RequeueDeadTask method")
+	}
+
+	mmRequeueDeadTask.mock.funcRequeueDeadTask = f
+	mmRequeueDeadTask.mock.funcRequeueDeadTaskOrigin = minimock.CallerInfo(1)
+	return mmRequeueDeadTask.mock
+}
+
+// When sets expectation for the ITaskQueue.RequeueDeadTask which will trigger the result defined by the following
+// Then helper
+func (mmRequeueDeadTask *mITaskQueueMockRequeueDeadTask) When(ctx context.Context, taskID string) *ITaskQueueMockRequeueDeadTaskExpectation {
+	if mmRequeueDeadTask.mock.funcRequeueDeadTask != nil {
+		mmRequeueDeadTask.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTask mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockRequeueDeadTaskExpectation{
+		mock:               mmRequeueDeadTask.mock,
+		params:             &ITaskQueueMockRequeueDeadTaskParams{ctx, taskID},
+		expectationOrigins: ITaskQueueMockRequeueDeadTaskExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRequeueDeadTask.expectations = append(mmRequeueDeadTask.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.RequeueDeadTask return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockRequeueDeadTaskExpectation) Then(tp1 *mm_queue.TaskInfo, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockRequeueDeadTaskResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.RequeueDeadTask should be invoked
+func (mmRequeueDeadTask *mITaskQueueMockRequeueDeadTask) Times(n uint64) *mITaskQueueMockRequeueDeadTask {
+	if n == 0 {
+		mmRequeueDeadTask.mock.t.Fatalf("Times of ITaskQueueMock.RequeueDeadTask mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequeueDeadTask.expectedInvocations, n)
+	mmRequeueDeadTask.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequeueDeadTask
+}
+
+func (mmRequeueDeadTask *mITaskQueueMockRequeueDeadTask) invocationsDone() bool {
+	if len(mmRequeueDeadTask.expectations) == 0 && mmRequeueDeadTask.defaultExpectation == nil && mmRequeueDeadTask.mock.funcRequeueDeadTask == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequeueDeadTask.mock.afterRequeueDeadTaskCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequeueDeadTask.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RequeueDeadTask implements ITaskQueue
+func (mmRequeueDeadTask *ITaskQueueMock) RequeueDeadTask(ctx context.Context, taskID string) (tp1 *mm_queue.TaskInfo, err error) {
+	mm_atomic.AddUint64(&mmRequeueDeadTask.beforeRequeueDeadTaskCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequeueDeadTask.afterRequeueDeadTaskCounter, 1)
+
+	mmRequeueDeadTask.t.Helper()
+
+	if mmRequeueDeadTask.inspectFuncRequeueDeadTask != nil {
+		mmRequeueDeadTask.inspectFuncRequeueDeadTask(ctx, taskID)
+	}
+
+	mm_params := ITaskQueueMockRequeueDeadTaskParams{ctx, taskID}
+
+	// Record call args
+	mmRequeueDeadTask.RequeueDeadTaskMock.mutex.Lock()
+	mmRequeueDeadTask.RequeueDeadTaskMock.callArgs = append(mmRequeueDeadTask.RequeueDeadTaskMock.callArgs, &mm_params)
+	mmRequeueDeadTask.RequeueDeadTaskMock.mutex.Unlock()
+
+	for _, e := range mmRequeueDeadTask.RequeueDeadTaskMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmRequeueDeadTask.RequeueDeadTaskMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequeueDeadTask.RequeueDeadTaskMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequeueDeadTask.RequeueDeadTaskMock.defaultExpectation.params
+		mm_want_ptrs := mmRequeueDeadTask.RequeueDeadTaskMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockRequeueDeadTaskParams{ctx, taskID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequeueDeadTask.t.Errorf("ITaskQueueMock.RequeueDeadTask got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequeueDeadTask.RequeueDeadTaskMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.taskID != nil && !minimock.Equal(*mm_want_ptrs.taskID, mm_got.taskID) {
+				mmRequeueDeadTask.t.Errorf("ITaskQueueMock.RequeueDeadTask got unexpected parameter taskID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequeueDeadTask.RequeueDeadTaskMock.defaultExpectation.expectationOrigins.originTaskID, *mm_want_ptrs.taskID, mm_got.taskID, minimock.Diff(*mm_want_ptrs.taskID, mm_got.taskID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequeueDeadTask.t.Errorf("ITaskQueueMock.RequeueDeadTask got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequeueDeadTask.RequeueDeadTaskMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequeueDeadTask.RequeueDeadTaskMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequeueDeadTask.t.Fatal("No results are set for the ITaskQueueMock.RequeueDeadTask")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmRequeueDeadTask.funcRequeueDeadTask != nil {
+		return mmRequeueDeadTask.funcRequeueDeadTask(ctx, taskID)
+	}
+	mmRequeueDeadTask.t.Fatalf("Unexpected call to ITaskQueueMock.RequeueDeadTask. %v %v", ctx, taskID)
+	return
+}
+
+// RequeueDeadTaskAfterCounter returns a count of finished ITaskQueueMock.RequeueDeadTask invocations
+func (mmRequeueDeadTask *ITaskQueueMock) RequeueDeadTaskAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequeueDeadTask.afterRequeueDeadTaskCounter)
+}
+
+// RequeueDeadTaskBeforeCounter returns a count of ITaskQueueMock.RequeueDeadTask invocations
+func (mmRequeueDeadTask *ITaskQueueMock) RequeueDeadTaskBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequeueDeadTask.beforeRequeueDeadTaskCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.RequeueDeadTask.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequeueDeadTask *mITaskQueueMockRequeueDeadTask) Calls() []*ITaskQueueMockRequeueDeadTaskParams {
+	mmRequeueDeadTask.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockRequeueDeadTaskParams, len(mmRequeueDeadTask.callArgs))
+	copy(argCopy, mmRequeueDeadTask.callArgs)
+
+	mmRequeueDeadTask.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequeueDeadTaskDone returns true if the count of the RequeueDeadTask invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockRequeueDeadTaskDone() bool {
+	if m.RequeueDeadTaskMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequeueDeadTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequeueDeadTaskMock.invocationsDone()
+}
+
+// MinimockRequeueDeadTaskInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockRequeueDeadTaskInspect() {
+	for _, e := range m.RequeueDeadTaskMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.RequeueDeadTask at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRequeueDeadTaskCounter := mm_atomic.LoadUint64(&m.afterRequeueDeadTaskCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequeueDeadTaskMock.defaultExpectation != nil && afterRequeueDeadTaskCounter < 1 {
+		if m.RequeueDeadTaskMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.RequeueDeadTask at\n%s", m.RequeueDeadTaskMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.RequeueDeadTask at\n%s with params: %#v", m.RequeueDeadTaskMock.defaultExpectation.expectationOrigins.origin, *m.RequeueDeadTaskMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequeueDeadTask != nil && afterRequeueDeadTaskCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.RequeueDeadTask at\n%s", m.funcRequeueDeadTaskOrigin)
+	}
+
+	if !m.RequeueDeadTaskMock.invocationsDone() && afterRequeueDeadTaskCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.RequeueDeadTask at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequeueDeadTaskMock.expectedInvocations), m.RequeueDeadTaskMock.expectedInvocationsOrigin, afterRequeueDeadTaskCounter)
+	}
+}
+
+type mITaskQueueMockRequeueDeadTasks struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockRequeueDeadTasksExpectation
+	expectations       []*ITaskQueueMockRequeueDeadTasksExpectation
+
+	callArgs []*ITaskQueueMockRequeueDeadTasksParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockRequeueDeadTasksExpectation specifies expectation struct of the ITaskQueue.RequeueDeadTasks
+type ITaskQueueMockRequeueDeadTasksExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockRequeueDeadTasksParams
+	paramPtrs          *ITaskQueueMockRequeueDeadTasksParamPtrs
+	expectationOrigins ITaskQueueMockRequeueDeadTasksExpectationOrigins
+	results            *ITaskQueueMockRequeueDeadTasksResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockRequeueDeadTasksParams contains parameters of the ITaskQueue.RequeueDeadTasks
+type ITaskQueueMockRequeueDeadTasksParams struct {
+	ctx context.Context
+}
+
+// ITaskQueueMockRequeueDeadTasksParamPtrs contains pointers to parameters of the ITaskQueue.RequeueDeadTasks
+type ITaskQueueMockRequeueDeadTasksParamPtrs struct {
+	ctx *context.Context
+}
+
+// ITaskQueueMockRequeueDeadTasksResults contains results of the ITaskQueue.RequeueDeadTasks
+type ITaskQueueMockRequeueDeadTasksResults struct {
+	i1  int64
+	err error
+}
+
+// ITaskQueueMockRequeueDeadTasksOrigins contains origins of expectations of the ITaskQueue.RequeueDeadTasks
+type ITaskQueueMockRequeueDeadTasksExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequeueDeadTasks *mITaskQueueMockRequeueDeadTasks) Optional() *mITaskQueueMockRequeueDeadTasks {
+	mmRequeueDeadTasks.optional = true
+	return mmRequeueDeadTasks
+}
+
+// Expect sets up expected params for ITaskQueue.RequeueDeadTasks
+func (mmRequeueDeadTasks *mITaskQueueMockRequeueDeadTasks) Expect(ctx context.Context) *mITaskQueueMockRequeueDeadTasks {
+	if mmRequeueDeadTasks.mock.funcRequeueDeadTasks != nil {
+		mmRequeueDeadTasks.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTasks mock is already set by Set")
+	}
+
+	if mmRequeueDeadTasks.defaultExpectation == nil {
+		mmRequeueDeadTasks.defaultExpectation = &ITaskQueueMockRequeueDeadTasksExpectation{}
+	}
+
+	if mmRequeueDeadTasks.defaultExpectation.paramPtrs != nil {
+		mmRequeueDeadTasks.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTasks mock is already set by ExpectParams functions")
+	}
+
+	mmRequeueDeadTasks.defaultExpectation.params = &ITaskQueueMockRequeueDeadTasksParams{ctx}
+	mmRequeueDeadTasks.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequeueDeadTasks.expectations {
+		if minimock.Equal(e.params, mmRequeueDeadTasks.defaultExpectation.params) {
+			mmRequeueDeadTasks.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequeueDeadTasks.defaultExpectation.params)
+		}
+	}
+
+	return mmRequeueDeadTasks
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.RequeueDeadTasks
+func (mmRequeueDeadTasks *mITaskQueueMockRequeueDeadTasks) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockRequeueDeadTasks {
+	if mmRequeueDeadTasks.mock.funcRequeueDeadTasks != nil {
+		mmRequeueDeadTasks.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTasks mock is already set by Set")
+	}
+
+	if mmRequeueDeadTasks.defaultExpectation == nil {
+		mmRequeueDeadTasks.defaultExpectation = &ITaskQueueMockRequeueDeadTasksExpectation{}
+	}
+
+	if mmRequeueDeadTasks.defaultExpectation.params != nil {
+		mmRequeueDeadTasks.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTasks mock is already set by Expect")
+	}
+
+	if mmRequeueDeadTasks.defaultExpectation.paramPtrs == nil {
+		mmRequeueDeadTasks.defaultExpectation.paramPtrs = &ITaskQueueMockRequeueDeadTasksParamPtrs{}
+	}
+	mmRequeueDeadTasks.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRequeueDeadTasks.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRequeueDeadTasks
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.RequeueDeadTasks
+func (mmRequeueDeadTasks *mITaskQueueMockRequeueDeadTasks) Inspect(f func(ctx context.Context)) *mITaskQueueMockRequeueDeadTasks {
+	if mmRequeueDeadTasks.mock.inspectFuncRequeueDeadTasks != nil {
+		mmRequeueDeadTasks.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.RequeueDeadTasks")
+	}
+
+	mmRequeueDeadTasks.mock.inspectFuncRequeueDeadTasks = f
+
+	return mmRequeueDeadTasks
+}
+
+// Return sets up results that will be returned by ITaskQueue.RequeueDeadTasks
+func (mmRequeueDeadTasks *mITaskQueueMockRequeueDeadTasks) Return(i1 int64, err error) *ITaskQueueMock {
+	if mmRequeueDeadTasks.mock.funcRequeueDeadTasks != nil {
+		mmRequeueDeadTasks.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTasks mock is already set by Set")
+	}
+
+	if mmRequeueDeadTasks.defaultExpectation == nil {
+		mmRequeueDeadTasks.defaultExpectation = &ITaskQueueMockRequeueDeadTasksExpectation{mock: mmRequeueDeadTasks.mock}
+	}
+	mmRequeueDeadTasks.defaultExpectation.results = &ITaskQueueMockRequeueDeadTasksResults{i1, err}
+	mmRequeueDeadTasks.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequeueDeadTasks.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.RequeueDeadTasks method
+func (mmRequeueDeadTasks *mITaskQueueMockRequeueDeadTasks) Set(f func(ctx context.Context) (i1 int64, err error)) *ITaskQueueMock {
+	if mmRequeueDeadTasks.defaultExpectation != nil {
+		mmRequeueDeadTasks.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.RequeueDeadTasks method")
+	}
+
+	if len(mmRequeueDeadTasks.expectations) > 0 {
+		mmRequeueDeadTasks.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.RequeueDeadTasks method")
+	}
+
+	mmRequeueDeadTasks.mock.funcRequeueDeadTasks = f
+	mmRequeueDeadTasks.mock.funcRequeueDeadTasksOrigin = minimock.CallerInfo(1)
+	return mmRequeueDeadTasks.mock
+}
+
+// When sets expectation for the ITaskQueue.RequeueDeadTasks which will trigger the result defined by the following
+// Then helper
+func (mmRequeueDeadTasks *mITaskQueueMockRequeueDeadTasks) When(ctx context.Context) *ITaskQueueMockRequeueDeadTasksExpectation {
+	if mmRequeueDeadTasks.mock.funcRequeueDeadTasks != nil {
+		mmRequeueDeadTasks.mock.t.Fatalf("ITaskQueueMock.RequeueDeadTasks mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockRequeueDeadTasksExpectation{
+		mock:               mmRequeueDeadTasks.mock,
+		params:             &ITaskQueueMockRequeueDeadTasksParams{ctx},
+		expectationOrigins: ITaskQueueMockRequeueDeadTasksExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRequeueDeadTasks.expectations = append(mmRequeueDeadTasks.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.RequeueDeadTasks return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockRequeueDeadTasksExpectation) Then(i1 int64, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockRequeueDeadTasksResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.RequeueDeadTasks should be invoked
+func (mmRequeueDeadTasks *mITaskQueueMockRequeueDeadTasks) Times(n uint64) *mITaskQueueMockRequeueDeadTasks {
+	if n == 0 {
+		mmRequeueDeadTasks.mock.t.Fatalf("Times of ITaskQueueMock.RequeueDeadTasks mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequeueDeadTasks.expectedInvocations, n)
+	mmRequeueDeadTasks.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequeueDeadTasks
+}
+
+func (mmRequeueDeadTasks *mITaskQueueMockRequeueDeadTasks) invocationsDone() bool {
+	if len(mmRequeueDeadTasks.expectations) == 0 && mmRequeueDeadTasks.defaultExpectation == nil && mmRequeueDeadTasks.mock.funcRequeueDeadTasks == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequeueDeadTasks.mock.afterRequeueDeadTasksCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequeueDeadTasks.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RequeueDeadTasks implements ITaskQueue
+func (mmRequeueDeadTasks *ITaskQueueMock) RequeueDeadTasks(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmRequeueDeadTasks.beforeRequeueDeadTasksCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequeueDeadTasks.afterRequeueDeadTasksCounter, 1)
+
+	mmRequeueDeadTasks.t.Helper()
+
+	if mmRequeueDeadTasks.inspectFuncRequeueDeadTasks != nil {
+		mmRequeueDeadTasks.inspectFuncRequeueDeadTasks(ctx)
+	}
+
+	mm_params := ITaskQueueMockRequeueDeadTasksParams{ctx}
+
+	// Record call args
+	mmRequeueDeadTasks.RequeueDeadTasksMock.mutex.Lock()
+	mmRequeueDeadTasks.RequeueDeadTasksMock.callArgs = append(mmRequeueDeadTasks.RequeueDeadTasksMock.callArgs, &mm_params)
+	mmRequeueDeadTasks.RequeueDeadTasksMock.mutex.Unlock()
+
+	for _, e := range mmRequeueDeadTasks.RequeueDeadTasksMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmRequeueDeadTasks.RequeueDeadTasksMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequeueDeadTasks.RequeueDeadTasksMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequeueDeadTasks.RequeueDeadTasksMock.defaultExpectation.params
+		mm_want_ptrs := mmRequeueDeadTasks.RequeueDeadTasksMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockRequeueDeadTasksParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequeueDeadTasks.t.Errorf("ITaskQueueMock.RequeueDeadTasks got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequeueDeadTasks.RequeueDeadTasksMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequeueDeadTasks.t.Errorf("ITaskQueueMock.RequeueDeadTasks got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequeueDeadTasks.RequeueDeadTasksMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequeueDeadTasks.RequeueDeadTasksMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequeueDeadTasks.t.Fatal("No results are set for the ITaskQueueMock.RequeueDeadTasks")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmRequeueDeadTasks.funcRequeueDeadTasks != nil {
+		return mmRequeueDeadTasks.funcRequeueDeadTasks(ctx)
+	}
+	mmRequeueDeadTasks.t.Fatalf("Unexpected call to ITaskQueueMock.RequeueDeadTasks. %v", ctx)
+	return
+}
+
+// RequeueDeadTasksAfterCounter returns a count of finished ITaskQueueMock.RequeueDeadTasks invocations
+func (mmRequeueDeadTasks *ITaskQueueMock) RequeueDeadTasksAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequeueDeadTasks.afterRequeueDeadTasksCounter)
+}
+
+// RequeueDeadTasksBeforeCounter returns a count of ITaskQueueMock.RequeueDeadTasks invocations
+func (mmRequeueDeadTasks *ITaskQueueMock) RequeueDeadTasksBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequeueDeadTasks.beforeRequeueDeadTasksCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.RequeueDeadTasks.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequeueDeadTasks *mITaskQueueMockRequeueDeadTasks) Calls() []*ITaskQueueMockRequeueDeadTasksParams {
+	mmRequeueDeadTasks.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockRequeueDeadTasksParams, len(mmRequeueDeadTasks.callArgs))
+	copy(argCopy, mmRequeueDeadTasks.callArgs)
+
+	mmRequeueDeadTasks.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequeueDeadTasksDone returns true if the count of the RequeueDeadTasks invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockRequeueDeadTasksDone() bool {
+	if m.RequeueDeadTasksMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequeueDeadTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequeueDeadTasksMock.invocationsDone()
+}
+
+// MinimockRequeueDeadTasksInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockRequeueDeadTasksInspect() {
+	for _, e := range m.RequeueDeadTasksMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.RequeueDeadTasks at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRequeueDeadTasksCounter := mm_atomic.LoadUint64(&m.afterRequeueDeadTasksCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequeueDeadTasksMock.defaultExpectation != nil && afterRequeueDeadTasksCounter < 1 {
+		if m.RequeueDeadTasksMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.RequeueDeadTasks at\n%s", m.RequeueDeadTasksMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.RequeueDeadTasks at\n%s with params: %#v", m.RequeueDeadTasksMock.defaultExpectation.expectationOrigins.origin, *m.RequeueDeadTasksMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequeueDeadTasks != nil && afterRequeueDeadTasksCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.RequeueDeadTasks at\n%s", m.funcRequeueDeadTasksOrigin)
+	}
+
+	if !m.RequeueDeadTasksMock.invocationsDone() && afterRequeueDeadTasksCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.RequeueDeadTasks at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequeueDeadTasksMock.expectedInvocations), m.RequeueDeadTasksMock.expectedInvocationsOrigin, afterRequeueDeadTasksCounter)
+	}
+}
+
+type mITaskQueueMockResumeQueue struct {
+	optional           bool
+	mock               *ITaskQueueMock
+	defaultExpectation *ITaskQueueMockResumeQueueExpectation
+	expectations       []*ITaskQueueMockResumeQueueExpectation
+
+	callArgs []*ITaskQueueMockResumeQueueParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ITaskQueueMockResumeQueueExpectation specifies expectation struct of the ITaskQueue.ResumeQueue
+type ITaskQueueMockResumeQueueExpectation struct {
+	mock               *ITaskQueueMock
+	params             *ITaskQueueMockResumeQueueParams
+	paramPtrs          *ITaskQueueMockResumeQueueParamPtrs
+	expectationOrigins ITaskQueueMockResumeQueueExpectationOrigins
+	results            *ITaskQueueMockResumeQueueResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ITaskQueueMockResumeQueueParams contains parameters of the ITaskQueue.ResumeQueue
+type ITaskQueueMockResumeQueueParams struct {
+	ctx   context.Context
+	name  string
+	shard int
+}
+
+// ITaskQueueMockResumeQueueParamPtrs contains pointers to parameters of the ITaskQueue.ResumeQueue
+type ITaskQueueMockResumeQueueParamPtrs struct {
+	ctx   *context.Context
+	name  *string
+	shard *int
+}
+
+// ITaskQueueMockResumeQueueResults contains results of the ITaskQueue.ResumeQueue
+type ITaskQueueMockResumeQueueResults struct {
+	qp1 *mm_queue.QueueStatus
+	err error
+}
+
+// ITaskQueueMockResumeQueueOrigins contains origins of expectations of the ITaskQueue.ResumeQueue
+type ITaskQueueMockResumeQueueExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originName  string
+	originShard string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmResumeQueue *mITaskQueueMockResumeQueue) Optional() *mITaskQueueMockResumeQueue {
+	mmResumeQueue.optional = true
+	return mmResumeQueue
+}
+
+// Expect sets up expected params for ITaskQueue.ResumeQueue
+func (mmResumeQueue *mITaskQueueMockResumeQueue) Expect(ctx context.Context, name string, shard int) *mITaskQueueMockResumeQueue {
+	if mmResumeQueue.mock.funcResumeQueue != nil {
+		mmResumeQueue.mock.t.Fatalf("ITaskQueueMock.ResumeQueue mock is already set by Set")
+	}
+
+	if mmResumeQueue.defaultExpectation == nil {
+		mmResumeQueue.defaultExpectation = &ITaskQueueMockResumeQueueExpectation{}
+	}
+
+	if mmResumeQueue.defaultExpectation.paramPtrs != nil {
+		mmResumeQueue.mock.t.Fatalf("ITaskQueueMock.ResumeQueue mock is already set by ExpectParams functions")
+	}
+
+	mmResumeQueue.defaultExpectation.params = &ITaskQueueMockResumeQueueParams{ctx, name, shard}
+	mmResumeQueue.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmResumeQueue.expectations {
+		if minimock.Equal(e.params, mmResumeQueue.defaultExpectation.params) {
+			mmResumeQueue.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmResumeQueue.defaultExpectation.params)
+		}
+	}
+
+	return mmResumeQueue
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ITaskQueue.ResumeQueue
+func (mmResumeQueue *mITaskQueueMockResumeQueue) ExpectCtxParam1(ctx context.Context) *mITaskQueueMockResumeQueue {
+	if mmResumeQueue.mock.funcResumeQueue != nil {
+		mmResumeQueue.mock.t.Fatalf("ITaskQueueMock.ResumeQueue mock is already set by Set")
+	}
+
+	if mmResumeQueue.defaultExpectation == nil {
+		mmResumeQueue.defaultExpectation = &ITaskQueueMockResumeQueueExpectation{}
+	}
+
+	if mmResumeQueue.defaultExpectation.params != nil {
+		mmResumeQueue.mock.t.Fatalf("ITaskQueueMock.ResumeQueue mock is already set by Expect")
+	}
+
+	if mmResumeQueue.defaultExpectation.paramPtrs == nil {
+		mmResumeQueue.defaultExpectation.paramPtrs = &ITaskQueueMockResumeQueueParamPtrs{}
+	}
+	mmResumeQueue.defaultExpectation.paramPtrs.ctx = &ctx
+	mmResumeQueue.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmResumeQueue
+}
+
+// ExpectNameParam2 sets up expected param name for ITaskQueue.ResumeQueue
+func (mmResumeQueue *mITaskQueueMockResumeQueue) ExpectNameParam2(name string) *mITaskQueueMockResumeQueue {
+	if mmResumeQueue.mock.funcResumeQueue != nil {
+		mmResumeQueue.mock.t.Fatalf("ITaskQueueMock.ResumeQueue mock is already set by Set")
+	}
+
+	if mmResumeQueue.defaultExpectation == nil {
+		mmResumeQueue.defaultExpectation = &ITaskQueueMockResumeQueueExpectation{}
+	}
+
+	if mmResumeQueue.defaultExpectation.params != nil {
+		mmResumeQueue.mock.t.Fatalf("ITaskQueueMock.ResumeQueue mock is already set by Expect")
+	}
+
+	if mmResumeQueue.defaultExpectation.paramPtrs == nil {
+		mmResumeQueue.defaultExpectation.paramPtrs = &ITaskQueueMockResumeQueueParamPtrs{}
+	}
+	mmResumeQueue.defaultExpectation.paramPtrs.name = &name
+	mmResumeQueue.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmResumeQueue
+}
+
+// ExpectShardParam3 sets up expected param shard for ITaskQueue.ResumeQueue
+func (mmResumeQueue *mITaskQueueMockResumeQueue) ExpectShardParam3(shard int) *mITaskQueueMockResumeQueue {
+	if mmResumeQueue.mock.funcResumeQueue != nil {
+		mmResumeQueue.mock.t.Fatalf("ITaskQueueMock.ResumeQueue mock is already set by Set")
+	}
+
+	if mmResumeQueue.defaultExpectation == nil {
+		mmResumeQueue.defaultExpectation = &ITaskQueueMockResumeQueueExpectation{}
+	}
+
+	if mmResumeQueue.defaultExpectation.params != nil {
+		mmResumeQueue.mock.t.Fatalf("ITaskQueueMock.ResumeQueue mock is already set by Expect")
+	}
+
+	if mmResumeQueue.defaultExpectation.paramPtrs == nil {
+		mmResumeQueue.defaultExpectation.paramPtrs = &ITaskQueueMockResumeQueueParamPtrs{}
+	}
+	mmResumeQueue.defaultExpectation.paramPtrs.shard = &shard
+	mmResumeQueue.defaultExpectation.expectationOrigins.originShard = minimock.CallerInfo(1)
+
+	return mmResumeQueue
+}
+
+// Inspect accepts an inspector function that has same arguments as the ITaskQueue.ResumeQueue
+func (mmResumeQueue *mITaskQueueMockResumeQueue) Inspect(f func(ctx context.Context, name string, shard int)) *mITaskQueueMockResumeQueue {
+	if mmResumeQueue.mock.inspectFuncResumeQueue != nil {
+		mmResumeQueue.mock.t.Fatalf("Inspect function is already set for ITaskQueueMock.ResumeQueue")
+	}
+
+	mmResumeQueue.mock.inspectFuncResumeQueue = f
+
+	return mmResumeQueue
+}
+
+// Return sets up results that will be returned by ITaskQueue.ResumeQueue
+func (mmResumeQueue *mITaskQueueMockResumeQueue) Return(qp1 *mm_queue.QueueStatus, err error) *ITaskQueueMock {
+	if mmResumeQueue.mock.funcResumeQueue != nil {
+		mmResumeQueue.mock.t.Fatalf("ITaskQueueMock.ResumeQueue mock is already set by Set")
+	}
+
+	if mmResumeQueue.defaultExpectation == nil {
+		mmResumeQueue.defaultExpectation = &ITaskQueueMockResumeQueueExpectation{mock: mmResumeQueue.mock}
+	}
+	mmResumeQueue.defaultExpectation.results = &ITaskQueueMockResumeQueueResults{qp1, err}
+	mmResumeQueue.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmResumeQueue.mock
+}
+
+// Set uses given function f to mock the ITaskQueue.ResumeQueue method
+func (mmResumeQueue *mITaskQueueMockResumeQueue) Set(f func(ctx context.Context, name string, shard int) (qp1 *mm_queue.QueueStatus, err error)) *ITaskQueueMock {
+	if mmResumeQueue.defaultExpectation != nil {
+		mmResumeQueue.mock.t.Fatalf("Default expectation is already set for the ITaskQueue.ResumeQueue method")
+	}
+
+	if len(mmResumeQueue.expectations) > 0 {
+		mmResumeQueue.mock.t.Fatalf("Some expectations are already set for the ITaskQueue.ResumeQueue method")
+	}
+
+	mmResumeQueue.mock.funcResumeQueue = f
+	mmResumeQueue.mock.funcResumeQueueOrigin = minimock.CallerInfo(1)
+	return mmResumeQueue.mock
+}
+
+// When sets expectation for the ITaskQueue.ResumeQueue which will trigger the result defined by the following
+// Then helper
+func (mmResumeQueue *mITaskQueueMockResumeQueue) When(ctx context.Context, name string, shard int) *ITaskQueueMockResumeQueueExpectation {
+	if mmResumeQueue.mock.funcResumeQueue != nil {
+		mmResumeQueue.mock.t.Fatalf("ITaskQueueMock.ResumeQueue mock is already set by Set")
+	}
+
+	expectation := &ITaskQueueMockResumeQueueExpectation{
+		mock:               mmResumeQueue.mock,
+		params:             &ITaskQueueMockResumeQueueParams{ctx, name, shard},
+		expectationOrigins: ITaskQueueMockResumeQueueExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmResumeQueue.expectations = append(mmResumeQueue.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ITaskQueue.ResumeQueue return parameters for the expectation previously defined by the When method
+func (e *ITaskQueueMockResumeQueueExpectation) Then(qp1 *mm_queue.QueueStatus, err error) *ITaskQueueMock {
+	e.results = &ITaskQueueMockResumeQueueResults{qp1, err}
+	return e.mock
+}
+
+// Times sets number of times ITaskQueue.ResumeQueue should be invoked
+func (mmResumeQueue *mITaskQueueMockResumeQueue) Times(n uint64) *mITaskQueueMockResumeQueue {
+	if n == 0 {
+		mmResumeQueue.mock.t.Fatalf("Times of ITaskQueueMock.ResumeQueue mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmResumeQueue.expectedInvocations, n)
+	mmResumeQueue.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmResumeQueue
+}
+
+func (mmResumeQueue *mITaskQueueMockResumeQueue) invocationsDone() bool {
+	if len(mmResumeQueue.expectations) == 0 && mmResumeQueue.defaultExpectation == nil && mmResumeQueue.mock.funcResumeQueue == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmResumeQueue.mock.afterResumeQueueCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmResumeQueue.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ResumeQueue implements ITaskQueue
+func (mmResumeQueue *ITaskQueueMock) ResumeQueue(ctx context.Context, name string, shard int) (qp1 *mm_queue.QueueStatus, err error) {
+	mm_atomic.AddUint64(&mmResumeQueue.beforeResumeQueueCounter, 1)
+	defer mm_atomic.AddUint64(&mmResumeQueue.afterResumeQueueCounter, 1)
+
+	mmResumeQueue.t.Helper()
+
+	if mmResumeQueue.inspectFuncResumeQueue != nil {
+		mmResumeQueue.inspectFuncResumeQueue(ctx, name, shard)
+	}
+
+	mm_params := ITaskQueueMockResumeQueueParams{ctx, name, shard}
+
+	// Record call args
+	mmResumeQueue.ResumeQueueMock.mutex.Lock()
+	mmResumeQueue.ResumeQueueMock.callArgs = append(mmResumeQueue.ResumeQueueMock.callArgs, &mm_params)
+	mmResumeQueue.ResumeQueueMock.mutex.Unlock()
+
+	for _, e := range mmResumeQueue.ResumeQueueMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.qp1, e.results.err
+		}
+	}
+
+	if mmResumeQueue.ResumeQueueMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmResumeQueue.ResumeQueueMock.defaultExpectation.Counter, 1)
+		mm_want := mmResumeQueue.ResumeQueueMock.defaultExpectation.params
+		mm_want_ptrs := mmResumeQueue.ResumeQueueMock.defaultExpectation.paramPtrs
+
+		mm_got := ITaskQueueMockResumeQueueParams{ctx, name, shard}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmResumeQueue.t.Errorf("ITaskQueueMock.ResumeQueue got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResumeQueue.ResumeQueueMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmResumeQueue.t.Errorf("ITaskQueueMock.ResumeQueue got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResumeQueue.ResumeQueueMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+			if mm_want_ptrs.shard != nil && !minimock.Equal(*mm_want_ptrs.shard, mm_got.shard) {
+				mmResumeQueue.t.Errorf("ITaskQueueMock.ResumeQueue got unexpected parameter shard, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmResumeQueue.ResumeQueueMock.defaultExpectation.expectationOrigins.originShard, *mm_want_ptrs.shard, mm_got.shard, minimock.Diff(*mm_want_ptrs.shard, mm_got.shard))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmResumeQueue.t.Errorf("ITaskQueueMock.ResumeQueue got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmResumeQueue.ResumeQueueMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmResumeQueue.ResumeQueueMock.defaultExpectation.results
+		if mm_results == nil {
+			mmResumeQueue.t.Fatal("No results are set for the ITaskQueueMock.ResumeQueue")
+		}
+		return (*mm_results).qp1, (*mm_results).err
+	}
+	if mmResumeQueue.funcResumeQueue != nil {
+		return mmResumeQueue.funcResumeQueue(ctx, name, shard)
+	}
+	mmResumeQueue.t.Fatalf("Unexpected call to ITaskQueueMock.ResumeQueue. %v %v %v", ctx, name, shard)
+	return
+}
+
+// ResumeQueueAfterCounter returns a count of finished ITaskQueueMock.ResumeQueue invocations
+func (mmResumeQueue *ITaskQueueMock) ResumeQueueAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResumeQueue.afterResumeQueueCounter)
+}
+
+// ResumeQueueBeforeCounter returns a count of ITaskQueueMock.ResumeQueue invocations
+func (mmResumeQueue *ITaskQueueMock) ResumeQueueBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmResumeQueue.beforeResumeQueueCounter)
+}
+
+// Calls returns a list of arguments used in each call to ITaskQueueMock.ResumeQueue.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmResumeQueue *mITaskQueueMockResumeQueue) Calls() []*ITaskQueueMockResumeQueueParams {
+	mmResumeQueue.mutex.RLock()
+
+	argCopy := make([]*ITaskQueueMockResumeQueueParams, len(mmResumeQueue.callArgs))
+	copy(argCopy, mmResumeQueue.callArgs)
+
+	mmResumeQueue.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockResumeQueueDone returns true if the count of the ResumeQueue invocations corresponds
+// the number of defined expectations
+func (m *ITaskQueueMock) MinimockResumeQueueDone() bool {
+	if m.ResumeQueueMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ResumeQueueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ResumeQueueMock.invocationsDone()
+}
+
+// MinimockResumeQueueInspect logs each unmet expectation
+func (m *ITaskQueueMock) MinimockResumeQueueInspect() {
+	for _, e := range m.ResumeQueueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ITaskQueueMock.ResumeQueue at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterResumeQueueCounter := mm_atomic.LoadUint64(&m.afterResumeQueueCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ResumeQueueMock.defaultExpectation != nil && afterResumeQueueCounter < 1 {
+		if m.ResumeQueueMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ITaskQueueMock.ResumeQueue at\n%s", m.ResumeQueueMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ITaskQueueMock.ResumeQueue at\n%s with params: %#v", m.ResumeQueueMock.defaultExpectation.expectationOrigins.origin, *m.ResumeQueueMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcResumeQueue != nil && afterResumeQueueCounter < 1 {
+		m.t.Errorf("Expected call to ITaskQueueMock.ResumeQueue at\n%s", m.funcResumeQueueOrigin)
+	}
+
+	if !m.ResumeQueueMock.invocationsDone() && afterResumeQueueCounter > 0 {
+		m.t.Errorf("Expected %d calls to ITaskQueueMock.ResumeQueue at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ResumeQueueMock.expectedInvocations), m.ResumeQueueMock.expectedInvocationsOrigin, afterResumeQueueCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ITaskQueueMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddTaskInspect()
+
+			m.MinimockAddTasksInspect()
+
+			m.MinimockCancelTaskInspect()
+
+			m.MinimockGetDeadTaskInspect()
+
+			m.MinimockGetResultInspect()
+
+			m.MinimockGetTaskInspect()
+
+			m.MinimockListDeadTasksInspect()
+
+			m.MinimockListQueuesInspect()
+
+			m.MinimockPauseQueueInspect()
+
+			m.MinimockProcessTasksInspect()
+
+			m.MinimockPurgeDeadTaskInspect()
+
+			m.MinimockPurgeDeadTasksInspect()
+
+			m.MinimockRequeueDeadTaskInspect()
+
+			m.MinimockRequeueDeadTasksInspect()
+
+			m.MinimockResumeQueueInspect()
 		}
 	})
 }
@@ -792,5 +5470,18 @@ func (m *ITaskQueueMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddTaskDone() &&
-		m.MinimockProcessTasksDone()
+		m.MinimockAddTasksDone() &&
+		m.MinimockCancelTaskDone() &&
+		m.MinimockGetDeadTaskDone() &&
+		m.MinimockGetResultDone() &&
+		m.MinimockGetTaskDone() &&
+		m.MinimockListDeadTasksDone() &&
+		m.MinimockListQueuesDone() &&
+		m.MinimockPauseQueueDone() &&
+		m.MinimockProcessTasksDone() &&
+		m.MinimockPurgeDeadTaskDone() &&
+		m.MinimockPurgeDeadTasksDone() &&
+		m.MinimockRequeueDeadTaskDone() &&
+		m.MinimockRequeueDeadTasksDone() &&
+		m.MinimockResumeQueueDone()
 }
@@ -0,0 +1,77 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// pipelineSize сколько вызовов add_task.lua отправляется в Redis одним конвейером
+const pipelineSize = 1000
+
+// AddTaskResult итог добавления одной задачи пакета: Info при успехе или Err
+type AddTaskResult struct {
+	Info *TaskInfo
+	Err  error
+}
+
+// AddTasks добавляет пакет задач. Задачи группируются по шардам очередей, и вызовы add_task.lua
+// каждого шарда отправляются конвейером. Итог возвращается для каждой задачи в порядке specs;
+// ошибка возвращается, только если пакет не удалось отправить в Redis целиком
+func (tq *TaskQueue) AddTasks(ctx context.Context, specs []TaskSpec) ([]AddTaskResult, error) {
+	results := make([]AddTaskResult, len(specs))
+	prepared := make([]*newTask, len(specs))
+	byShard := make(map[queueShard][]int)
+	for i, spec := range specs {
+		nt, err := tq.newTask(spec)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		prepared[i] = nt
+		at := queueShard{queue: nt.queue, shard: nt.shard}
+		byShard[at] = append(byShard[at], i)
+	}
+	if len(byShard) == 0 {
+		return results, nil
+	}
+
+	// EVALSHA в конвейере не может сам откатиться на EVAL, поэтому загружаем скрипт заранее
+	if err := tq.addTaskScript.Load(ctx, tq.client).Err(); err != nil {
+		tq.logger.Error("Failed to load add_task script",
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to load add_task script: %w", err)
+	}
+
+	for at, indices := range byShard {
+		for start := 0; start < len(indices); start += pipelineSize {
+			chunk := indices[start:min(start+pipelineSize, len(indices))]
+
+			pipe := tq.client.Pipeline()
+			cmds := make([]*redis.Cmd, len(chunk))
+			for j, i := range chunk {
+				cmds[j] = tq.addTaskScript.EvalSha(ctx, pipe, prepared[i].scriptKeys, prepared[i].scriptArgs...)
+			}
+			// Ошибки разбираем по каждой команде ниже
+			_, _ = pipe.Exec(ctx)
+
+			for j, i := range chunk {
+				result, err := cmds[j].Slice()
+				if err != nil {
+					tq.logger.Error("Failed to execute add_task script",
+						zap.String("task_id", prepared[i].task.ID),
+						zap.String("queue", at.queue.name),
+						zap.Int("shard", at.shard),
+						zap.Error(err))
+					results[i].Err = fmt.Errorf("failed to execute add_task script: %w", err)
+					continue
+				}
+				results[i].Info, results[i].Err = tq.addedTask(prepared[i], result)
+			}
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,68 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrTaskInFlight возвращается при отмене задачи, которую уже выполняет воркер
+	ErrTaskInFlight = errors.New("task is already running")
+	// ErrTaskDead возвращается при отмене задачи, которая уже лежит в dead_letter_queue
+	ErrTaskDead = errors.New("task is in dead letter queue")
+	// ErrTaskFinished возвращается при отмене уже выполненной или отменённой задачи
+	ErrTaskFinished = errors.New("task is already finished")
+)
+
+// CancelTask удаляет ждущую или отложенную задачу из очереди её шарда.
+// Запись отменённой задачи хранится retention. Для задачи, которую уже
+// выполняет воркер, возвращает ErrTaskInFlight
+func (tq *TaskQueue) CancelTask(ctx context.Context, taskID string) (*TaskInfo, error) {
+	q, shard := tq.locate(taskID)
+	keys := tq.keys(q, shard)
+
+	result, err := tq.cancelTaskScript.Run(ctx, tq.client,
+		[]string{keys.priority, keys.delayed, tq.taskKey(taskID)},
+		taskID, tq.cfg.Queues.Retention).Slice()
+	if err != nil {
+		tq.logger.Error("Failed to execute cancel_task script",
+			zap.String("task_id", taskID),
+			zap.Int("shard", shard),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to execute cancel_task script: %w", err)
+	}
+
+	if cancelled, _ := result[0].(int64); cancelled == 0 {
+		state, _ := result[1].(string)
+		switch state {
+		case "not_found":
+			return nil, ErrTaskNotFound
+		case string(TaskStateRunning):
+			return nil, ErrTaskInFlight
+		case string(TaskStateDead):
+			return nil, ErrTaskDead
+		default:
+			return nil, ErrTaskFinished
+		}
+	}
+
+	var task Task
+	taskJSON, _ := result[2].(string)
+	if err := json.Unmarshal([]byte(taskJSON), &task); err != nil {
+		// Задача уже удалена из очереди, поэтому повреждённая запись не мешает отмене
+		task.ID = taskID
+	}
+	task.State = TaskStateCancelled
+
+	tq.logger.Info("Task cancelled",
+		zap.String("task_id", taskID),
+		zap.String("task_type", task.Type),
+		zap.Int("shard", shard))
+	tq.metrics.IncrementCancelled(ctx)
+
+	return newTaskInfo(task, shard), nil
+}
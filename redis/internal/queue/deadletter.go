@@ -0,0 +1,280 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// ErrTaskCorrupt возвращается при повторе задачи из dead_letter_queue, запись которой не разобрать
+var ErrTaskCorrupt = errors.New("task record is corrupt")
+
+// ErrUniqueKeyTaken возвращается при повторе задачи из dead_letter_queue, ключ уникальности которой
+// уже занят другой активной задачей
+var ErrUniqueKeyTaken = errors.New("unique key is held by another active task")
+
+// DeadTask задача из dead_letter_queue вместе с данными для разбора
+type DeadTask struct {
+	*TaskInfo
+	Payload string `json:"payload"`
+}
+
+// DeadTaskPage страница задач из dead_letter_queue
+type DeadTaskPage struct {
+	Total  int64       `json:"total"` // Сколько задач во всех шардах dead_letter_queue
+	Offset int         `json:"offset"`
+	Limit  int         `json:"limit"`
+	Items  []*DeadTask `json:"items"`
+}
+
+// newDeadTask собирает DeadTask по записи задачи. Если записи нет или её не разобрать,
+// возвращает только идентификатор, шард и состояние
+func newDeadTask(taskID string, shard int, taskJSON string) *DeadTask {
+	var task Task
+	if taskJSON == "" || json.Unmarshal([]byte(taskJSON), &task) != nil {
+		return &DeadTask{TaskInfo: &TaskInfo{ID: taskID, Shard: shard, State: TaskStateDead}}
+	}
+	return &DeadTask{TaskInfo: newTaskInfo(task, shard), Payload: task.Payload}
+}
+
+// ListDeadTasks возвращает страницу задач из dead_letter_queue всех очередей. Очереди идут по имени,
+// шарды по порядку, внутри шарда задачи упорядочены от последней попавшей в очередь к первой
+func (tq *TaskQueue) ListDeadTasks(ctx context.Context, offset, limit int) (*DeadTaskPage, error) {
+	shards := tq.allShards()
+	pipe := tq.client.Pipeline()
+	lenCmds := make([]*redis.IntCmd, len(shards))
+	for i, s := range shards {
+		lenCmds[i] = pipe.LLen(ctx, tq.keys(s.queue, s.shard).deadLetter)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		tq.logger.Error("Failed to get dead letter queue length",
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to get dead letter queue length: %w", err)
+	}
+
+	page := &DeadTaskPage{Offset: offset, Limit: limit, Items: []*DeadTask{}}
+	for _, cmd := range lenCmds {
+		page.Total += cmd.Val()
+	}
+
+	// Выбираем из каждого шарда часть страницы, которая в него попадает
+	pipe = tq.client.Pipeline()
+	rangeCmds := make(map[int]*redis.StringSliceCmd)
+	skip, need := int64(offset), int64(limit)
+	for i, cmd := range lenCmds {
+		if need == 0 {
+			break
+		}
+		if skip >= cmd.Val() {
+			skip -= cmd.Val()
+			continue
+		}
+		rangeCmds[i] = pipe.LRange(ctx, tq.keys(shards[i].queue, shards[i].shard).deadLetter, skip, skip+need-1)
+		need -= min(need, cmd.Val()-skip)
+		skip = 0
+	}
+	if len(rangeCmds) == 0 {
+		return page, nil
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		tq.logger.Error("Failed to list dead letter queue",
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to list dead letter queue: %w", err)
+	}
+
+	pipe = tq.client.Pipeline()
+	var taskShards []int
+	var taskIDs []string
+	var taskCmds []*redis.StringCmd
+	for i := range lenCmds {
+		cmd, ok := rangeCmds[i]
+		if !ok {
+			continue
+		}
+		for _, taskID := range cmd.Val() {
+			taskShards = append(taskShards, shards[i].shard)
+			taskIDs = append(taskIDs, taskID)
+			taskCmds = append(taskCmds, pipe.Get(ctx, tq.taskKey(taskID)))
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		tq.logger.Error("Failed to get dead tasks",
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to get dead tasks: %w", err)
+	}
+
+	for i, cmd := range taskCmds {
+		page.Items = append(page.Items, newDeadTask(taskIDs[i], taskShards[i], cmd.Val()))
+	}
+	return page, nil
+}
+
+// GetDeadTask возвращает задачу из dead_letter_queue вместе с её полезной нагрузкой
+func (tq *TaskQueue) GetDeadTask(ctx context.Context, taskID string) (*DeadTask, error) {
+	q, shard := tq.locate(taskID)
+
+	pipe := tq.client.TxPipeline()
+	posCmd := pipe.LPos(ctx, tq.keys(q, shard).deadLetter, taskID, redis.LPosArgs{})
+	taskCmd := pipe.Get(ctx, tq.taskKey(taskID))
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		tq.logger.Error("Failed to look up dead task",
+			zap.String("task_id", taskID),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to look up dead task: %w", err)
+	}
+
+	if errors.Is(posCmd.Err(), redis.Nil) {
+		return nil, ErrTaskNotFound
+	}
+	return newDeadTask(taskID, shard, taskCmd.Val()), nil
+}
+
+// RequeueDeadTask возвращает задачу из dead_letter_queue в priority_queue, сбрасывая Attempts.
+// Задача снова берёт блокировку уникальности; если её ключ занят другой активной задачей,
+// задача остаётся в dead_letter_queue и возвращается ErrUniqueKeyTaken
+func (tq *TaskQueue) RequeueDeadTask(ctx context.Context, taskID string) (*TaskInfo, error) {
+	q, shard := tq.locate(taskID)
+	keys := tq.keys(q, shard)
+
+	result, err := tq.requeueDeadScript.Run(ctx, tq.client,
+		[]string{keys.deadLetter, keys.priority, keys.notify, keys.sequence},
+		taskID, 0, tq.taskKey("")).Slice()
+	if err != nil {
+		tq.logger.Error("Failed to execute requeue_dead script",
+			zap.String("task_id", taskID),
+			zap.Int("shard", shard),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to execute requeue_dead script: %w", err)
+	}
+
+	removed, _ := result[1].(int64)
+	taskJSON, _ := result[2].(string)
+	status, _ := result[3].(string)
+	tq.metrics.DecrementDeadLetter(ctx, removed)
+
+	switch status {
+	case "requeued":
+	case "corrupt":
+		return nil, ErrTaskCorrupt
+	case "duplicate":
+		return nil, ErrUniqueKeyTaken
+	default:
+		// Идентификатор без записи удаляется из очереди, но вернуть его некуда
+		return nil, ErrTaskNotFound
+	}
+
+	var task Task
+	if err := json.Unmarshal([]byte(taskJSON), &task); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task: %w", err)
+	}
+
+	tq.logger.Info("Dead task requeued",
+		zap.String("task_id", taskID),
+		zap.String("task_type", task.Type),
+		zap.Int("shard", shard))
+	return newTaskInfo(task, shard), nil
+}
+
+// RequeueDeadTasks возвращает все задачи из dead_letter_queue в priority_queue, сбрасывая Attempts.
+// Задачи с повреждёнными записями и с ключом уникальности, занятым другой активной задачей, остаются
+// в dead_letter_queue. Возвращает, сколько задач возвращено
+func (tq *TaskQueue) RequeueDeadTasks(ctx context.Context) (int64, error) {
+	var total int64
+	err := tq.drainDeadLetter(ctx, func(keys shardKeys, limit int64) error {
+		result, err := tq.requeueDeadScript.Run(ctx, tq.client,
+			[]string{keys.deadLetter, keys.priority, keys.notify, keys.sequence},
+			"", limit, tq.taskKey("")).Slice()
+		if err != nil {
+			return fmt.Errorf("failed to execute requeue_dead script: %w", err)
+		}
+		requeued, _ := result[0].(int64)
+		removed, _ := result[1].(int64)
+		tq.metrics.DecrementDeadLetter(ctx, removed)
+		total += requeued
+		return nil
+	})
+
+	tq.logger.Info("Dead tasks requeued",
+		zap.Int64("count", total))
+	return total, err
+}
+
+// PurgeDeadTask удаляет задачу из dead_letter_queue вместе с её записью
+func (tq *TaskQueue) PurgeDeadTask(ctx context.Context, taskID string) error {
+	q, shard := tq.locate(taskID)
+
+	removed, err := tq.purgeDeadScript.Run(ctx, tq.client,
+		[]string{tq.keys(q, shard).deadLetter},
+		taskID, 0, tq.taskKey("")).Int64()
+	if err != nil {
+		tq.logger.Error("Failed to execute purge_dead script",
+			zap.String("task_id", taskID),
+			zap.Int("shard", shard),
+			zap.Error(err))
+		return fmt.Errorf("failed to execute purge_dead script: %w", err)
+	}
+	if removed == 0 {
+		return ErrTaskNotFound
+	}
+
+	tq.logger.Info("Dead task purged",
+		zap.String("task_id", taskID),
+		zap.Int("shard", shard))
+	tq.metrics.DecrementDeadLetter(ctx, removed)
+	return nil
+}
+
+// PurgeDeadTasks удаляет все задачи из dead_letter_queue вместе с их записями.
+// Возвращает, сколько задач удалено
+func (tq *TaskQueue) PurgeDeadTasks(ctx context.Context) (int64, error) {
+	var total int64
+	err := tq.drainDeadLetter(ctx, func(keys shardKeys, limit int64) error {
+		removed, err := tq.purgeDeadScript.Run(ctx, tq.client,
+			[]string{keys.deadLetter},
+			"", limit, tq.taskKey("")).Int64()
+		if err != nil {
+			return fmt.Errorf("failed to execute purge_dead script: %w", err)
+		}
+		tq.metrics.DecrementDeadLetter(ctx, removed)
+		total += removed
+		return nil
+	})
+
+	tq.logger.Info("Dead tasks purged",
+		zap.Int64("count", total))
+	return total, err
+}
+
+// drainDeadLetter обходит dead_letter_queue каждого шарда всех очередей с конца пачками по batchSize.
+// Обходится не больше задач, чем было в очереди до начала, поэтому оставленные
+// в очереди и новые задачи не обрабатываются повторно
+func (tq *TaskQueue) drainDeadLetter(ctx context.Context, run func(keys shardKeys, limit int64) error) error {
+	for _, s := range tq.allShards() {
+		keys := tq.keys(s.queue, s.shard)
+		left, err := tq.client.LLen(ctx, keys.deadLetter).Result()
+		if err != nil {
+			tq.logger.Error("Failed to get dead letter queue length",
+				zap.String("queue", s.queue.name),
+				zap.Int("shard", s.shard),
+				zap.Error(err))
+			return fmt.Errorf("failed to get dead letter queue length: %w", err)
+		}
+
+		for left > 0 {
+			limit := min(left, batchSize)
+			if err := run(keys, limit); err != nil {
+				tq.logger.Error("Failed to process dead letter queue",
+					zap.String("queue", s.queue.name),
+					zap.Int("shard", s.shard),
+					zap.Error(err))
+				return err
+			}
+			left -= limit
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrTaskTimeout причина отмены контекста обработчика, не уложившегося в таймаут задачи.
+// Такая попытка считается неудачной и повторяется по политике повторов
+var ErrTaskTimeout = errors.New("task execution timed out")
+
+// PermanentError ошибка обработчика, после которой повтор не поможет:
+// задача сразу уходит в dead_letter_queue, не расходуя оставшиеся попытки
+type PermanentError struct {
+	Err error
+}
+
+// Permanent помечает ошибку обработчика как неисправимую
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{Err: err}
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// RetryAfterError просит повторить задачу через Delay, не расходуя попытку,
+// например когда внешний сервис ограничил частоту запросов
+type RetryAfterError struct {
+	Delay time.Duration
+	Err   error
+}
+
+// RetryAfter возвращает ошибку обработчика, откладывающую задачу на delay без расхода попытки
+func RetryAfter(delay time.Duration, err error) error {
+	return &RetryAfterError{Delay: delay, Err: err}
+}
+
+func (e *RetryAfterError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("retry after %s", e.Delay)
+	}
+	return fmt.Sprintf("retry after %s: %v", e.Delay, e.Err)
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+// isPermanent сообщает, что задачу с такой ошибкой повторять бессмысленно
+func isPermanent(err error) bool {
+	var permanent *PermanentError
+	return errors.As(err, &permanent) || errors.Is(err, ErrUnknownTaskType)
+}
@@ -0,0 +1,138 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPermanent(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "Nil error",
+			err:      nil,
+			expected: false,
+		},
+		{
+			name:     "Plain error",
+			err:      errBoom,
+			expected: false,
+		},
+		{
+			name:     "Permanent error",
+			err:      Permanent(errBoom),
+			expected: true,
+		},
+		{
+			name:     "Wrapped permanent error",
+			err:      fmt.Errorf("failed to send email: %w", Permanent(errBoom)),
+			expected: true,
+		},
+		{
+			name:     "Unknown task type",
+			err:      fmt.Errorf("%w: %q", ErrUnknownTaskType, "report"),
+			expected: true,
+		},
+		{
+			name:     "Retry after error",
+			err:      RetryAfter(time.Second, errBoom),
+			expected: false,
+		},
+		{
+			name:     "Timeout",
+			err:      fmt.Errorf("%w after %s", ErrTaskTimeout, time.Second),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isPermanent(tt.err))
+		})
+	}
+}
+
+func TestPermanent(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	tests := []struct {
+		name      string
+		err       error
+		expected  string
+		permanent bool
+	}{
+		{
+			name:      "Nil error stays nil",
+			err:       nil,
+			permanent: false,
+		},
+		{
+			name:      "Error keeps its message",
+			err:       errBoom,
+			expected:  "boom",
+			permanent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Permanent(tt.err)
+			if !tt.permanent {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.expected)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+		delay    time.Duration
+	}{
+		{
+			name:     "Without cause",
+			err:      RetryAfter(time.Second, nil),
+			expected: "retry after 1s",
+			delay:    time.Second,
+		},
+		{
+			name:     "With cause",
+			err:      RetryAfter(5*time.Second, errBoom),
+			expected: "retry after 5s: boom",
+			delay:    5 * time.Second,
+		},
+		{
+			name:     "Wrapped by handler",
+			err:      fmt.Errorf("rate limited by provider: %w", RetryAfter(time.Minute, errBoom)),
+			expected: "rate limited by provider: retry after 1m0s: boom",
+			delay:    time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.EqualError(t, tt.err, tt.expected)
+
+			var retryAfter *RetryAfterError
+			if assert.ErrorAs(t, tt.err, &retryAfter) {
+				assert.Equal(t, tt.delay, retryAfter.Delay)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// ErrTaskNotFound возвращается, если задачи с таким идентификатором нет в очереди
+var ErrTaskNotFound = errors.New("task not found")
+
+// TransitionInfo переход задачи в новое состояние
+type TransitionInfo struct {
+	State TaskState `json:"state"`
+	At    time.Time `json:"at"`
+}
+
+// AttemptInfo попытка выполнения задачи
+type AttemptInfo struct {
+	StartedAt time.Time `json:"started_at"`
+	Duration  int64     `json:"duration"` // Длительность попытки, мс
+	Error     string    `json:"error,omitempty"`
+	Worker    string    `json:"worker"`
+}
+
+// TaskInfo описывает задачу и её текущее состояние
+type TaskInfo struct {
+	ID              string           `json:"id"`
+	Type            string           `json:"type"`
+	Queue           string           `json:"queue,omitempty"`
+	Shard           int              `json:"shard"`
+	State           TaskState        `json:"state"`
+	Priority        int              `json:"priority"`
+	Attempts        int              `json:"attempts"`
+	NextExecutionAt *time.Time       `json:"next_execution_at,omitempty"` // Когда отложенная задача станет доступна воркерам
+	LeaseExpiresAt  *time.Time       `json:"lease_expires_at,omitempty"`  // Когда истечёт аренда выполняемой задачи
+	Transitions     []TransitionInfo `json:"transitions,omitempty"`
+	LastError       string           `json:"last_error,omitempty"` // Ошибка последней неудачной попытки
+	FailedAt        *time.Time       `json:"failed_at,omitempty"`
+	History         []AttemptInfo    `json:"history,omitempty"`   // Последние попытки выполнения
+	Retry           *RetryPolicy     `json:"retry,omitempty"`     // Политика повторов, заданная при добавлении задачи
+	Duplicate       bool             `json:"duplicate,omitempty"` // Вместо новой задачи возвращена уже добавленная с тем же ключом
+}
+
+// newTaskInfo собирает TaskInfo по записи задачи
+func newTaskInfo(task Task, shard int) *TaskInfo {
+	info := &TaskInfo{
+		ID:        task.ID,
+		Type:      task.Type,
+		Queue:     task.Queue,
+		Shard:     shard,
+		State:     task.State,
+		Priority:  task.Priority,
+		Attempts:  task.Attempts,
+		LastError: task.LastError,
+		Retry:     task.Retry,
+	}
+	for _, t := range task.Transitions {
+		info.Transitions = append(info.Transitions, TransitionInfo{State: t.State, At: time.UnixMilli(t.At).UTC()})
+	}
+	if task.FailedAt > 0 {
+		failedAt := time.UnixMilli(task.FailedAt).UTC()
+		info.FailedAt = &failedAt
+	}
+	for _, a := range task.History {
+		info.History = append(info.History, AttemptInfo{
+			StartedAt: time.UnixMilli(a.StartedAt).UTC(),
+			Duration:  a.Duration,
+			Error:     a.Error,
+			Worker:    a.Worker,
+		})
+	}
+	if info.Queue == "" {
+		info.Queue = DefaultQueue
+	}
+	return info
+}
+
+// GetTask возвращает задачу по идентификатору вместе с её текущим состоянием
+func (tq *TaskQueue) GetTask(ctx context.Context, taskID string) (*TaskInfo, error) {
+	q, shard := tq.locate(taskID)
+	keys := tq.keys(q, shard)
+
+	// Читаем запись и дедлайны задачи одной транзакцией, чтобы они были согласованы
+	pipe := tq.client.TxPipeline()
+	taskCmd := pipe.Get(ctx, tq.taskKey(taskID))
+	delayedCmd := pipe.ZScore(ctx, keys.delayed, taskID)
+	processingCmd := pipe.ZScore(ctx, keys.processing, taskID)
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		tq.logger.Error("Failed to look up task",
+			zap.String("task_id", taskID),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to look up task: %w", err)
+	}
+
+	taskJSON, err := taskCmd.Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrTaskNotFound
+	}
+
+	var task Task
+	if err := json.Unmarshal([]byte(taskJSON), &task); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task: %w", err)
+	}
+
+	info := newTaskInfo(task, shard)
+	if score, err := delayedCmd.Result(); err == nil {
+		nextExecutionAt := time.UnixMilli(int64(score)).UTC()
+		info.NextExecutionAt = &nextExecutionAt
+	}
+	if score, err := processingCmd.Result(); err == nil && task.State == TaskStateRunning {
+		leaseExpiresAt := time.UnixMilli(int64(score)).UTC()
+		info.LeaseExpiresAt = &leaseExpiresAt
+	}
+
+	return info, nil
+}
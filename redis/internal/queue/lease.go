@@ -0,0 +1,243 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// ErrLeaseLost означает, что аренда задачи истекла и задача могла уйти другому воркеру
+var ErrLeaseLost = errors.New("task lease lost")
+
+// errNoLease возвращается ExtendLease, если контекст не принадлежит обработчику задачи
+var errNoLease = errors.New("no task lease in context")
+
+// leaseKey ключ, под которым аренда задачи лежит в контексте обработчика
+type leaseKey struct{}
+
+// lease аренда задачи, взятой воркером в обработку
+type lease struct {
+	tq       *TaskQueue
+	keys     shardKeys
+	task     Task
+	taskJSON string // Запись задачи в том виде, в каком она была взята
+	token    string // Токен, которым воркер подтверждает владение задачей
+	result   string // Результат обработчика, сохраняемый при успешном подтверждении
+	started  time.Time
+	cancel   context.CancelCauseFunc
+}
+
+// ExtendLease продлевает аренду выполняемой задачи на d от текущего момента.
+// Вызывается из обработчика с переданным ему контекстом. Если аренду продлить
+// не удалось, контекст обработчика отменяется, чтобы задачу не выполняли
+// одновременно две реплики
+func ExtendLease(ctx context.Context, d time.Duration) error {
+	l, ok := ctx.Value(leaseKey{}).(*lease)
+	if !ok {
+		return errNoLease
+	}
+	return l.renew(ctx, d)
+}
+
+// renew продлевает аренду и отменяет контекст обработчика при неудаче
+func (l *lease) renew(ctx context.Context, d time.Duration) error {
+	renewed, err := l.tq.renewLeaseScript.Run(ctx, l.tq.client,
+		[]string{l.keys.processing, l.keys.leases},
+		l.task.ID, l.token, d.Milliseconds()).Int()
+	if err == nil && renewed == 0 {
+		err = ErrLeaseLost
+	}
+	if err != nil {
+		l.tq.logger.Warn("Failed to renew task lease, cancelling handler",
+			zap.String("task_id", l.task.ID),
+			zap.Error(err))
+		l.cancel(ErrLeaseLost)
+		return err
+	}
+	return nil
+}
+
+// heartbeat продлевает аренду на visibility_timeout каждую треть этого интервала,
+// пока не отменён ctx или аренду не удалось продлить. Интервал не короче миллисекунды, иначе тикер не создать
+func (l *lease) heartbeat(ctx context.Context) {
+	timeout := time.Duration(l.tq.cfg.Queues.VisibilityTimeout) * time.Millisecond
+	ticker := time.NewTicker(max(timeout/3, time.Millisecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := l.renew(ctx, timeout); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// ackAction действие, выполняемое при снятии задачи с processing_leases
+type ackAction string
+
+const (
+	ackDone  ackAction = "done"  // Задача выполнена, её запись хранится retention
+	ackRetry ackAction = "retry" // Задача возвращается в delayed_queue
+	ackDefer ackAction = "defer" // Задача возвращается в delayed_queue без расхода попытки
+	ackDead  ackAction = "dead"  // Задача переносится в dead_letter_queue
+)
+
+// claimTask атомарно извлекает следующую по режиму scheduling задачу шарда очереди и выдаёт на неё аренду
+// длиной visibility_timeout; worker записывается в историю попыток. Задачи, упёршиеся в ограничение частоты
+// своего типа или очереди, скрипт до выдачи откладывает в delayed_queue, не расходуя попытку.
+// Если выдать нечего, возвращает redis.Nil, если очередь или шард приостановлены — errShardPaused
+func (tq *TaskQueue) claimTask(ctx context.Context, q *namedQueue, shard int, worker string) (*lease, error) {
+	keys := tq.keys(q, shard)
+	token := uuid.New().String()
+	args := []interface{}{tq.cfg.Queues.VisibilityTimeout, token, tq.taskKey(""), worker, tq.cfg.Queues.HistoryLimit,
+		pauseField(q, AllShards), pauseField(q, shard)}
+	args = append(args, tq.rateLimitArgs(q)...)
+	args = append(args, batchSize)
+	args = append(args, tq.schedulingArgs()...)
+	result, err := tq.claimTaskScript.Run(ctx, tq.client,
+		[]string{keys.priority, keys.processing, keys.leases, keys.notify, keys.scheduler, tq.cfg.Queues.PausedKey,
+			keys.delayed, keys.delayedNotify},
+		args...).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, errShardPaused
+	}
+
+	if deferred, _ := strconv.ParseInt(result[3], 10, 64); deferred > 0 {
+		tq.logger.Debug("Tasks rate limited",
+			zap.String("queue", q.name),
+			zap.Int("shard", shard),
+			zap.Int64("deferred", deferred))
+		tq.metrics.IncrementRateLimited(ctx, deferred)
+	}
+	if result[0] == "" {
+		return nil, redis.Nil
+	}
+
+	l := &lease{tq: tq, keys: keys, taskJSON: result[1], token: token, started: time.Now()}
+	if err := json.Unmarshal([]byte(l.taskJSON), &l.task); err != nil {
+		// Повреждённую задачу выполнить нельзя, сохраняем её для разбора
+		l.task.ID = result[0]
+		if tq.ack(ctx, l, ackDead, l.taskJSON) {
+			tq.metrics.IncrementDeadLetter(ctx)
+		}
+		return nil, fmt.Errorf("failed to unmarshal task %s: %w", result[0], err)
+	}
+	// Задачи, поставленные до появления типов, выполняет обработчик по умолчанию
+	if l.task.Type == "" {
+		l.task.Type = DefaultTaskType
+	}
+
+	waited, _ := strconv.ParseInt(result[2], 10, 64)
+	tq.metrics.IncrementDequeued(ctx, l.task.Priority, time.Duration(waited)*time.Millisecond)
+	return l, nil
+}
+
+// finishAttempt записывает итог попытки в последнюю запись истории, открытую claim_task.lua,
+// и запоминает ошибку неудачной попытки. Время отказа проставляет ack_task.lua.
+// Отложенная обработчиком попытка неудачной не считается
+func (l *lease) finishAttempt(err error) {
+	var retryAfter *RetryAfterError
+	if err != nil && !errors.As(err, &retryAfter) {
+		l.task.LastError = err.Error()
+	}
+	if len(l.task.History) == 0 {
+		return
+	}
+	attempt := &l.task.History[len(l.task.History)-1]
+	attempt.Duration = time.Since(l.started).Milliseconds()
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+}
+
+// ackTask снимает задачу с processing_leases и атомарно переносит её в очередь назначения:
+// при повторе и откладывании — в delayed_queue на task.ExecuteAt, при отказе — в dead_letter_queue.
+// Возвращает false, если аренда уже истекла и задачу вернул в очередь reaper:
+// в этом случае результат выполнения отбрасывается, чтобы задача не задвоилась
+func (tq *TaskQueue) ackTask(ctx context.Context, l *lease, action ackAction) bool {
+	taskJSON, err := json.Marshal(l.task)
+	if err != nil {
+		tq.logger.Error("Failed to marshal task",
+			zap.String("task_id", l.task.ID),
+			zap.Error(err))
+		return false
+	}
+	return tq.ack(ctx, l, action, string(taskJSON))
+}
+
+// ack выполняет ack_task.lua с уже сериализованной записью задачи
+func (tq *TaskQueue) ack(ctx context.Context, l *lease, action ackAction, taskJSON string) bool {
+	acked, err := tq.ackTaskScript.Run(ctx, tq.client,
+		[]string{l.keys.processing, l.keys.leases, l.keys.delayed, l.keys.deadLetter, l.keys.delayedNotify, tq.taskKey(l.task.ID), tq.resultKey(l.task.ID)},
+		l.task.ID, l.token, string(action), taskJSON, l.task.ExecuteAt.UnixMilli(), tq.cfg.Queues.Retention,
+		l.result, tq.cfg.Queues.ResultTTL).Int()
+	if err != nil {
+		tq.logger.Error("Failed to acknowledge task",
+			zap.String("task_id", l.task.ID),
+			zap.String("action", string(action)),
+			zap.Error(err))
+		return false
+	}
+	if acked == 0 {
+		tq.logger.Warn("Task lease expired before acknowledgement, result discarded",
+			zap.String("task_id", l.task.ID),
+			zap.String("action", string(action)))
+		return false
+	}
+	return true
+}
+
+// reapExpiredTasks периодически возвращает в priority_queue задачи, аренда которых истекла.
+// Скрипт атомарен, поэтому reaper можно запускать сразу на нескольких репликах
+func (tq *TaskQueue) reapExpiredTasks(ctx context.Context, q *namedQueue, shard int) {
+	keys := tq.keys(q, shard)
+	ticker := time.NewTicker(time.Duration(tq.cfg.Queues.ReapInterval) * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			tq.logger.Info("Stopping lease reaper for shard due to context cancellation",
+				zap.String("queue", q.name),
+				zap.Int("shard", shard))
+			return
+		case <-ticker.C:
+			result, err := tq.reapTasksScript.Run(ctx, tq.client,
+				[]string{keys.processing, keys.leases, keys.priority, keys.notify, keys.deadLetter, keys.sequence},
+				tq.retryPolicy(Task{Queue: q.name}).MaxAttempts, batchSize, tq.taskKey("")).Int64Slice()
+			if err != nil {
+				tq.logger.Error("Error reaping expired tasks",
+					zap.String("queue", q.name),
+					zap.Int("shard", shard),
+					zap.Error(err))
+				continue
+			}
+
+			requeued, dead := result[0], result[1]
+			if requeued > 0 || dead > 0 {
+				tq.logger.Warn("Returned tasks with expired lease",
+					zap.String("queue", q.name),
+					zap.Int("shard", shard),
+					zap.Int64("requeued", requeued),
+					zap.Int64("dead_letter", dead))
+			}
+			for i := int64(0); i < dead; i++ {
+				tq.metrics.IncrementDeadLetter(ctx)
+			}
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// legacyTaskMatch шаблон элементов priority_queue и delayed_queue прежних версий, хранивших в них JSON задачи
+const legacyTaskMatch = "{*"
+
+// legacyProcessingKey префикс списков processing_queue:{shard} прежних версий с JSON выполнявшихся задач
+const legacyProcessingKey = "processing_queue"
+
+// legacyDeadLetterKey общий для всех шардов список прежних версий с JSON задач, исчерпавших попытки
+const legacyDeadLetterKey = "dead_letter_queue"
+
+// MigrateLegacy переводит данные, оставшиеся от версий без записей задач task:{id}, в текущий формат.
+// Вызывается до запуска воркеров; повторный вызов и одновременный вызов с нескольких реплик безопасны
+func (tq *TaskQueue) MigrateLegacy(ctx context.Context) error {
+	if err := tq.migrateLegacyTasks(ctx); err != nil {
+		return err
+	}
+	return tq.migrateLegacyDeadLetter(ctx)
+}
+
+// migrateLegacyTasks заменяет JSON-элементы priority_queue и delayed_queue очереди default их ID,
+// сохраняя задачу в запись: без записи claim_task.lua и перенос отложенных задач отбросили бы её.
+// Задачи, оставшиеся в processing_queue после падения воркера прежней версии, попадают в processing_leases
+// с истёкшей арендой, и их возвращает в очередь reaper
+func (tq *TaskQueue) migrateLegacyTasks(ctx context.Context) error {
+	q := tq.queues[DefaultQueue]
+	var migrated, dropped int64
+	for shard := 0; shard < q.shards; shard++ {
+		keys := tq.keys(q, shard)
+		for _, source := range []string{"priority", "delayed"} {
+			m, d, err := tq.migrateLegacyMembers(ctx, keys, shard, source)
+			if err != nil {
+				return err
+			}
+			migrated += m
+			dropped += d
+		}
+		m, d, err := tq.migrateLegacyProcessing(ctx, keys, shard)
+		if err != nil {
+			return err
+		}
+		migrated += m
+		dropped += d
+	}
+
+	if migrated > 0 || dropped > 0 {
+		tq.logger.Info("Migrated legacy tasks",
+			zap.Int64("migrated", migrated),
+			zap.Int64("dropped", dropped))
+	}
+	return nil
+}
+
+// migrateLegacyMembers переводит JSON-элементы одной очереди шарда (source: priority или delayed)
+// пачками по batchSize и возвращает, сколько задач переведено и сколько элементов отброшено
+func (tq *TaskQueue) migrateLegacyMembers(ctx context.Context, keys shardKeys, shard int, source string) (int64, int64, error) {
+	key := keys.priority
+	if source == "delayed" {
+		key = keys.delayed
+	}
+
+	var migrated, dropped int64
+	var cursor uint64
+	for {
+		// ZSCAN возвращает элементы вперемешку со score
+		entries, next, err := tq.client.ZScan(ctx, key, cursor, legacyTaskMatch, batchSize).Result()
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to scan %s for legacy tasks: %w", key, err)
+		}
+		if len(entries) > 0 {
+			members := make([]string, 0, len(entries)/2)
+			for i := 0; i < len(entries); i += 2 {
+				members = append(members, entries[i])
+			}
+			m, d, err := tq.migrateLegacyBatch(ctx, keys, shard, source, members)
+			if err != nil {
+				return 0, 0, fmt.Errorf("failed to migrate legacy tasks in %s: %w", key, err)
+			}
+			migrated += m
+			dropped += d
+		}
+		cursor = next
+		if cursor == 0 {
+			return migrated, dropped, nil
+		}
+	}
+}
+
+// migrateLegacyProcessing переводит задачи из списка processing_queue:{shard} прежних версий
+// пачками по batchSize. Скрипт удаляет из списка каждый прочитанный элемент, поэтому список читается с начала,
+// пока не опустеет
+func (tq *TaskQueue) migrateLegacyProcessing(ctx context.Context, keys shardKeys, shard int) (int64, int64, error) {
+	key := legacyProcessingList(shard)
+	var migrated, dropped int64
+	for {
+		members, err := tq.client.LRange(ctx, key, 0, batchSize-1).Result()
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to read %s for legacy tasks: %w", key, err)
+		}
+		if len(members) == 0 {
+			return migrated, dropped, nil
+		}
+		m, d, err := tq.migrateLegacyBatch(ctx, keys, shard, "processing", members)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to migrate legacy tasks in %s: %w", key, err)
+		}
+		migrated += m
+		dropped += d
+	}
+}
+
+// migrateLegacyBatch выполняет migrate_tasks.lua для пачки элементов источника source шарда
+func (tq *TaskQueue) migrateLegacyBatch(ctx context.Context, keys shardKeys, shard int, source string,
+	members []string) (int64, int64, error) {
+	args := []interface{}{source, tq.taskKey(""), DefaultTaskType}
+	for _, member := range members {
+		args = append(args, member)
+	}
+	result, err := tq.migrateTasksScript.Run(ctx, tq.client,
+		[]string{keys.priority, keys.delayed, keys.sequence, keys.notify, keys.delayedNotify, keys.processing, legacyProcessingList(shard)},
+		args...).Int64Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+	return result[0], result[1], nil
+}
+
+// migrateLegacyDeadLetter переносит задачи из общего dead_letter_queue прежних версий в шарды
+// dead_letter_queue очереди default, начиная с самой старой, и заводит для них записи в состоянии dead,
+// чтобы их можно было найти, повторить и удалить через /admin/dlq. После переноса счётчик dead_letter
+// приводится к числу задач в dead_letter_queue
+func (tq *TaskQueue) migrateLegacyDeadLetter(ctx context.Context) error {
+	q := tq.queues[DefaultQueue]
+	var migrated int64
+	for {
+		member, err := tq.client.LIndex(ctx, legacyDeadLetterKey, -1).Result()
+		if errors.Is(err, redis.Nil) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read legacy dead letter queue: %w", err)
+		}
+
+		task := legacyDeadTask(member)
+		taskJSON, err := json.Marshal(task)
+		if err != nil {
+			return fmt.Errorf("failed to marshal task: %w", err)
+		}
+		moved, err := tq.migrateDeadScript.Run(ctx, tq.client,
+			[]string{legacyDeadLetterKey, tq.keys(q, q.shard(task.ID)).deadLetter, tq.taskKey(task.ID)},
+			member, task.ID, string(taskJSON)).Int64()
+		if err != nil {
+			return fmt.Errorf("failed to migrate legacy dead task: %w", err)
+		}
+		migrated += moved
+	}
+	if migrated == 0 {
+		return nil
+	}
+
+	keys := []string{tq.cfg.Metrics.Key}
+	for _, s := range tq.allShards() {
+		keys = append(keys, tq.keys(s.queue, s.shard).deadLetter)
+	}
+	total, err := tq.countDeadScript.Run(ctx, tq.client, keys, "dead_letter").Int64()
+	if err != nil {
+		return fmt.Errorf("failed to count dead tasks: %w", err)
+	}
+
+	tq.logger.Info("Migrated legacy dead letter queue",
+		zap.Int64("migrated", migrated),
+		zap.Int64("dead_letter", total))
+	return nil
+}
+
+// legacyDeadTask собирает запись задачи из элемента общего dead_letter_queue прежних версий.
+// Элемент, который не разобрать, сохраняется целиком в payload задачи с новым ID
+func legacyDeadTask(member string) Task {
+	var task Task
+	if err := json.Unmarshal([]byte(member), &task); err != nil || task.ID == "" {
+		task = Task{ID: uuid.New().String(), Payload: member, LastError: "legacy dead letter entry is corrupt"}
+	}
+	if task.Type == "" {
+		task.Type = DefaultTaskType
+	}
+	task.State = TaskStateDead
+	task.Transitions = append(task.Transitions, Transition{State: TaskStateDead, At: time.Now().UnixMilli()})
+	return task
+}
+
+// legacyProcessingList возвращает ключ списка processing_queue шарда прежних версий
+func legacyProcessingList(shard int) string {
+	return fmt.Sprintf("%s:%d", legacyProcessingKey, shard)
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"hash/crc32"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"task-queue/internal/config"
@@ -17,48 +17,203 @@ import (
 	"go.uber.org/zap"
 )
 
+// batchSize сколько задач фоновые скрипты (перенос отложенных задач, reaper) и операции
+// над dead_letter_queue обрабатывают за вызов
+const batchSize = 100
+
+// handlerStopWarning через сколько после отмены воркер предупреждает, что обработчик не вернулся
+const handlerStopWarning = 10 * time.Second
+
 // ITaskQueue интерфейс для работы с очередью задач
 type ITaskQueue interface {
-	AddTask(ctx context.Context, payload string, priority int, executeAt time.Time) error
+	AddTask(ctx context.Context, taskType string, payload string, priority int, executeAt time.Time, opts TaskOptions) (*TaskInfo, error)
+	GetTask(ctx context.Context, taskID string) (*TaskInfo, error)
+	AddTasks(ctx context.Context, specs []TaskSpec) ([]AddTaskResult, error)
+	CancelTask(ctx context.Context, taskID string) (*TaskInfo, error)
+	GetResult(ctx context.Context, taskID string) (*TaskResult, error)
+	ListDeadTasks(ctx context.Context, offset, limit int) (*DeadTaskPage, error)
+	GetDeadTask(ctx context.Context, taskID string) (*DeadTask, error)
+	RequeueDeadTask(ctx context.Context, taskID string) (*TaskInfo, error)
+	RequeueDeadTasks(ctx context.Context) (int64, error)
+	PurgeDeadTask(ctx context.Context, taskID string) error
+	PurgeDeadTasks(ctx context.Context) (int64, error)
+	ListQueues(ctx context.Context) ([]*QueueStatus, error)
+	PauseQueue(ctx context.Context, name string, shard int) (*QueueStatus, error)
+	ResumeQueue(ctx context.Context, name string, shard int) (*QueueStatus, error)
 	ProcessTasks(ctx context.Context)
 }
 
 // TaskQueue реализует очередь задач
 type TaskQueue struct {
-	client        *redis.Client
-	metrics       *metrics.Metrics
-	cfg           *config.Config
-	addTaskScript *redis.Script
-	logger        *zap.Logger
+	client             *redis.Client
+	metrics            *metrics.Metrics
+	registry           *Registry
+	cfg                *config.Config
+	addTaskScript      *redis.Script
+	claimTaskScript    *redis.Script
+	ackTaskScript      *redis.Script
+	reapTasksScript    *redis.Script
+	renewLeaseScript   *redis.Script
+	promoteTasksScript *redis.Script
+	cancelTaskScript   *redis.Script
+	requeueDeadScript  *redis.Script
+	purgeDeadScript    *redis.Script
+	migrateTasksScript *redis.Script
+	migrateDeadScript  *redis.Script
+	countDeadScript    *redis.Script
+	worker             string        // Идентификатор процесса в истории попыток задач
+	slots              chan struct{} // Слоты общего ограничения queues.concurrency; nil — без ограничения
+	queues             map[string]*namedQueue
+	logger             *zap.Logger
 }
 
 // NewTaskQueue создаёт новый экземпляр TaskQueue
-func NewTaskQueue(client *redis.Client, metrics *metrics.Metrics, cfg *config.Config, logger *zap.Logger) *TaskQueue {
-	// Загружаем Lua-скрипт
-	scriptPath := filepath.Join("internal", "queue", "scripts", "add_task.lua")
-	scriptContent, err := os.ReadFile(scriptPath)
+func NewTaskQueue(client *redis.Client, metrics *metrics.Metrics, registry *Registry, cfg *config.Config, logger *zap.Logger) *TaskQueue {
+	return &TaskQueue{
+		client:             client,
+		metrics:            metrics,
+		registry:           registry,
+		cfg:                cfg,
+		addTaskScript:      loadScript("add_task.lua", logger),
+		claimTaskScript:    loadScript("claim_task.lua", logger),
+		ackTaskScript:      loadScript("ack_task.lua", logger),
+		reapTasksScript:    loadScript("reap_tasks.lua", logger),
+		renewLeaseScript:   loadScript("renew_lease.lua", logger),
+		promoteTasksScript: loadScript("promote_tasks.lua", logger),
+		cancelTaskScript:   loadScript("cancel_task.lua", logger),
+		requeueDeadScript:  loadScript("requeue_dead.lua", logger),
+		purgeDeadScript:    loadScript("purge_dead.lua", logger),
+		migrateTasksScript: loadScript("migrate_tasks.lua", logger),
+		migrateDeadScript:  loadScript("migrate_dead.lua", logger),
+		countDeadScript:    loadScript("count_dead.lua", logger),
+		worker:             workerID(),
+		slots:              newSlots(cfg.Queues.Concurrency),
+		queues:             newQueues(cfg),
+		logger:             logger,
+	}
+}
+
+// newSlots создаёт семафор на concurrency одновременно выполняемых задач
+func newSlots(concurrency int) chan struct{} {
+	if concurrency <= 0 {
+		return nil
+	}
+	return make(chan struct{}, concurrency)
+}
+
+// workerID возвращает идентификатор процесса воркера вида host:pid
+func workerID() string {
+	host, err := os.Hostname()
 	if err != nil {
-		logger.Fatal("Failed to load Lua script", zap.Error(err))
+		host = "unknown"
 	}
-	addTaskScript := redis.NewScript(string(scriptContent))
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
+}
 
-	return &TaskQueue{
-		client:        client,
-		metrics:       metrics,
-		cfg:           cfg,
-		addTaskScript: addTaskScript,
-		logger:        logger,
+// preludeScript общие функции Lua-скриптов, которые loadScript добавляет в начало каждого из них
+const preludeScript = "prelude.lua"
+
+// loadScript загружает Lua-скрипт из каталога scripts вместе с общими функциями из preludeScript
+func loadScript(name string, logger *zap.Logger) *redis.Script {
+	var source strings.Builder
+	for _, file := range []string{preludeScript, name} {
+		scriptPath := filepath.Join("internal", "queue", "scripts", file)
+		scriptContent, err := os.ReadFile(scriptPath)
+		if err != nil {
+			logger.Fatal("Failed to load Lua script",
+				zap.String("script", file),
+				zap.Error(err))
+		}
+		source.Write(scriptContent)
+		source.WriteString("\n")
+	}
+	return redis.NewScript(source.String())
+}
+
+// shardKeys ключи Redis, относящиеся к одному шарду
+type shardKeys struct {
+	priority      string
+	delayed       string
+	processing    string
+	leases        string
+	notify        string
+	scheduler     string
+	sequence      string
+	delayedNotify string
+	deadLetter    string
+}
+
+// taskKey возвращает ключ записи задачи. Очереди хранят только идентификаторы задач
+func (tq *TaskQueue) taskKey(taskID string) string {
+	return tq.cfg.Queues.TaskKey + ":" + taskID
+}
+
+// resultKey возвращает ключ результата задачи, который хранится рядом с её записью
+func (tq *TaskQueue) resultKey(taskID string) string {
+	return tq.taskKey(taskID) + ":result"
+}
+
+// AddTask добавляет задачу в очередь с использованием Lua-скрипта.
+// Если задача с тем же ключом идемпотентности уже добавлена или задача с тем же
+// ключом уникальности ещё не завершена, возвращает её с Duplicate
+func (tq *TaskQueue) AddTask(ctx context.Context, taskType string, payload string, priority int, executeAt time.Time, opts TaskOptions) (*TaskInfo, error) {
+	nt, err := tq.newTask(TaskSpec{Type: taskType, Payload: payload, Priority: priority, ExecuteAt: executeAt, Options: opts})
+	if err != nil {
+		return nil, err
+	}
+
+	// Используем Lua-скрипт для атомарного добавления
+	result, err := tq.addTaskScript.Run(ctx, tq.client, nt.scriptKeys, nt.scriptArgs...).Slice()
+	if err != nil {
+		tq.logger.Error("Failed to execute add_task script",
+			zap.String("task_id", nt.task.ID),
+			zap.Int("shard", nt.shard),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to execute add_task script: %w", err)
 	}
+
+	return tq.addedTask(nt, result)
+}
+
+// newTask задача, подготовленная к выполнению add_task.lua
+type newTask struct {
+	task       Task
+	opts       TaskOptions
+	queue      *namedQueue
+	shard      int
+	scriptKeys []string
+	scriptArgs []interface{}
 }
 
-// AddTask добавляет задачу в очередь с использованием Lua-скрипта
-func (tq *TaskQueue) AddTask(ctx context.Context, payload string, priority int, executeAt time.Time) error {
+// newTask собирает задачу и аргументы add_task.lua
+func (tq *TaskQueue) newTask(spec TaskSpec) (*newTask, error) {
+	if spec.Type == "" {
+		spec.Type = DefaultTaskType
+	}
+	q, err := tq.queue(spec.Options.Queue)
+	if err != nil {
+		return nil, err
+	}
+
 	task := Task{
-		ID:        uuid.New().String(),
-		Payload:   payload,
-		Priority:  priority,
-		ExecuteAt: executeAt,
+		ID:        q.newTaskID(uuid.New().String()),
+		Type:      spec.Type,
+		Queue:     q.name,
+		Payload:   spec.Payload,
+		Priority:  spec.Priority,
+		ExecuteAt: spec.ExecuteAt,
 		Attempts:  0,
+		Retry:     spec.Options.Retry,
+		Timeout:   spec.Options.Timeout.Milliseconds(),
+	}
+	if task.Retry != nil {
+		// Reaper видит только запись задачи, поэтому сохраняем в ней итоговое число попыток
+		policy := *task.Retry
+		policy.MaxAttempts = tq.retryPolicy(task).MaxAttempts
+		task.Retry = &policy
+	}
+	if spec.Options.UniqueKey != "" {
+		task.UniqueKey = tq.cfg.Queues.UniqueKey + ":" + spec.Options.UniqueKey
 	}
 
 	taskJSON, err := json.Marshal(task)
@@ -66,44 +221,86 @@ func (tq *TaskQueue) AddTask(ctx context.Context, payload string, priority int,
 		tq.logger.Error("Failed to marshal task",
 			zap.String("task_id", task.ID),
 			zap.Error(err))
-		return fmt.Errorf("failed to marshal task: %w", err)
+		return nil, fmt.Errorf("failed to marshal task: %w", err)
 	}
 
-	// Выбираем шард на основе хэша task.ID
-	shard := tq.getShard(task.ID)
-
-	// Формируем ключи для шарда
-	priorityQueueKey := fmt.Sprintf("%s:%d", tq.cfg.Queues.PriorityKey, shard)
-	delayedQueueKey := fmt.Sprintf("%s:%d", tq.cfg.Queues.DelayedKey, shard)
+	// Выбираем шард очереди на основе хэша task.ID
+	shard := q.shard(task.ID)
+	keys := tq.keys(q, shard)
 
 	// Логируем входные параметры
 	tq.logger.Debug("Executing add_task script",
 		zap.String("task_id", task.ID),
+		zap.String("task_type", task.Type),
+		zap.String("queue", q.name),
 		zap.Int("shard", shard),
-		zap.Int("priority", priority),
-		zap.Int64("execute_at_unix", executeAt.Unix()))
+		zap.Int("priority", task.Priority),
+		zap.Int64("execute_at_unix_ms", task.ExecuteAt.UnixMilli()))
 
-	// Используем Lua-скрипт для атомарного добавления
-	result, err := tq.addTaskScript.Run(ctx, tq.client, []string{priorityQueueKey, delayedQueueKey}, taskJSON, priority, executeAt.Unix()).Result()
-	if err != nil {
-		tq.logger.Error("Failed to execute add_task script",
-			zap.String("task_id", task.ID),
-			zap.Int("shard", shard),
-			zap.Any("result", result),
-			zap.Error(err))
-		return fmt.Errorf("failed to execute add_task script: %w", err)
+	var idempotencyKey string
+	if spec.Options.IdempotencyKey != "" {
+		idempotencyKey = tq.cfg.Queues.IdempotencyKey + ":" + spec.Options.IdempotencyKey
+	}
+
+	return &newTask{
+		task:  task,
+		opts:  spec.Options,
+		queue: q,
+		shard: shard,
+		scriptKeys: []string{keys.priority, keys.delayed, keys.notify, keys.delayedNotify,
+			tq.taskKey(task.ID), idempotencyKey, task.UniqueKey, keys.sequence},
+		scriptArgs: []interface{}{task.ID, taskJSON, task.Priority, task.ExecuteAt.UnixMilli(),
+			tq.cfg.Queues.IdempotencyTTL, tq.taskKey(""), spec.Options.UniqueFor.Milliseconds()},
+	}, nil
+}
+
+// addedTask разбирает ответ add_task.lua
+func (tq *TaskQueue) addedTask(nt *newTask, result []interface{}) (*TaskInfo, error) {
+	if added, _ := result[0].(int64); added == 0 {
+		return tq.duplicateTask(result, nt.opts)
+	}
+
+	// Скрипт возвращает запись задачи с первым переходом состояния
+	task := nt.task
+	record, _ := result[2].(string)
+	if err := json.Unmarshal([]byte(record), &task); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task: %w", err)
 	}
 
 	tq.logger.Info("Task added to queue",
 		zap.String("task_id", task.ID),
-		zap.Int("shard", shard),
-		zap.Int("priority", priority))
+		zap.String("task_type", task.Type),
+		zap.String("queue", task.Queue),
+		zap.Int("shard", nt.shard),
+		zap.Int("priority", task.Priority),
+		zap.String("state", string(task.State)))
 
-	return nil
+	info := newTaskInfo(task, nt.shard)
+	if info.State == TaskStateScheduled {
+		info.NextExecutionAt = &task.ExecuteAt
+	}
+	return info, nil
 }
 
-// getShard возвращает номер шарда на основе taskID
-func (tq *TaskQueue) getShard(taskID string) int {
-	hash := crc32.ChecksumIEEE([]byte(taskID))
-	return int(hash % uint32(tq.cfg.Queues.Shards))
+// duplicateTask собирает TaskInfo задачи, найденной add_task.lua по ключу идемпотентности или уникальности
+func (tq *TaskQueue) duplicateTask(result []interface{}, opts TaskOptions) (*TaskInfo, error) {
+	taskID, _ := result[1].(string)
+	_, shard := tq.locate(taskID)
+
+	tq.logger.Info("Task already added with the same key",
+		zap.String("task_id", taskID),
+		zap.String("idempotency_key", opts.IdempotencyKey),
+		zap.String("unique_key", opts.UniqueKey))
+
+	// Запись исходной задачи могла истечь раньше ключа идемпотентности
+	info := &TaskInfo{ID: taskID, Shard: shard}
+	if record, ok := result[2].(string); ok {
+		var task Task
+		if err := json.Unmarshal([]byte(record), &task); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal task: %w", err)
+		}
+		info = newTaskInfo(task, shard)
+	}
+	info.Duplicate = true
+	return info, nil
 }
@@ -0,0 +1,208 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"slices"
+	"strings"
+
+	"task-queue/internal/config"
+)
+
+// DefaultQueue очередь задач, добавленных без имени очереди. Её ключи и настройки берутся из queues
+const DefaultQueue = "default"
+
+// ErrUnknownQueue возвращается при добавлении задачи в очередь, которой нет в конфигурации
+var ErrUnknownQueue = errors.New("unknown queue")
+
+// namedQueue именованная очередь со своими ключами Redis, шардами, воркерами, политикой повторов
+// и ограничением частоты
+type namedQueue struct {
+	name      string
+	prefix    string // Префикс ключей Redis; у default пустой
+	shards    int
+	workers   int
+	retry     config.RetryConfig
+	rateLimit config.RateLimitConfig
+}
+
+// newQueues собирает очереди из конфигурации: default из queues и именованные из named_queues
+func newQueues(cfg *config.Config) map[string]*namedQueue {
+	queues := map[string]*namedQueue{
+		DefaultQueue: {
+			name:    DefaultQueue,
+			shards:  cfg.Queues.Shards,
+			workers: max(cfg.Queues.Workers, 1),
+			retry:   cfg.Retry,
+		},
+	}
+	for name, queueCfg := range cfg.NamedQueues {
+		q := &namedQueue{
+			name:      name,
+			prefix:    queueCfg.Prefix,
+			shards:    queueCfg.Shards,
+			workers:   queueCfg.Workers,
+			retry:     mergeRetryConfig(cfg.Retry, queueCfg.Retry),
+			rateLimit: queueCfg.RateLimit,
+		}
+		if q.prefix == "" && name != DefaultQueue {
+			q.prefix = name
+		}
+		if q.shards <= 0 {
+			q.shards = cfg.Queues.Shards
+		}
+		if q.workers <= 0 {
+			q.workers = max(cfg.Queues.Workers, 1)
+		}
+		queues[name] = q
+	}
+	return queues
+}
+
+// mergeRetryConfig дополняет настройки повторов очереди общими из retry. Если очередь сама задаёт
+// политику fixed или её задержки, общий max_attempts к ним не относится: без своего max_attempts
+// попыток на одну больше, чем задержек
+func mergeRetryConfig(base, override config.RetryConfig) config.RetryConfig {
+	if override.Type != "" || len(override.Delays) > 0 {
+		base.MaxAttempts = 0
+	}
+	if override.Type != "" {
+		base.Type = override.Type
+	}
+	if override.MaxAttempts > 0 {
+		base.MaxAttempts = override.MaxAttempts
+	}
+	if override.BackoffInitial > 0 {
+		base.BackoffInitial = override.BackoffInitial
+	}
+	if override.BackoffFactor > 0 {
+		base.BackoffFactor = override.BackoffFactor
+	}
+	if override.BackoffMax > 0 {
+		base.BackoffMax = override.BackoffMax
+	}
+	if override.Jitter > 0 {
+		base.Jitter = override.Jitter
+	}
+	if len(override.Delays) > 0 {
+		base.Delays = override.Delays
+	}
+	return base
+}
+
+// queue возвращает очередь по имени; пустое имя означает DefaultQueue
+func (tq *TaskQueue) queue(name string) (*namedQueue, error) {
+	if name == "" {
+		name = DefaultQueue
+	}
+	q, ok := tq.queues[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownQueue, name)
+	}
+	return q, nil
+}
+
+// subscribedQueues возвращает очереди, задачи которых выполняет этот процесс (queues.subscribe),
+// упорядоченные по имени
+func (tq *TaskQueue) subscribedQueues() []*namedQueue {
+	names := tq.cfg.Queues.Subscribe
+	if len(names) == 0 {
+		for name := range tq.queues {
+			names = append(names, name)
+		}
+	}
+	var queues []*namedQueue
+	for _, name := range names {
+		if q, ok := tq.queues[name]; ok {
+			queues = append(queues, q)
+		}
+	}
+	slices.SortFunc(queues, func(a, b *namedQueue) int { return strings.Compare(a.name, b.name) })
+	return queues
+}
+
+// BlockingConns возвращает, сколько соединений Redis ProcessTasks может одновременно занять
+// блокирующим BLPOP: по одному на каждого воркера и на перенос отложенных задач каждого шарда
+func BlockingConns(cfg *config.Config) int {
+	tq := &TaskQueue{cfg: cfg, queues: newQueues(cfg)}
+	conns := 0
+	for _, q := range tq.subscribedQueues() {
+		conns += q.shards * (q.workers + 1)
+	}
+	return conns
+}
+
+// allQueues возвращает все очереди, упорядоченные по имени
+func (tq *TaskQueue) allQueues() []*namedQueue {
+	queues := make([]*namedQueue, 0, len(tq.queues))
+	for _, q := range tq.queues {
+		queues = append(queues, q)
+	}
+	slices.SortFunc(queues, func(a, b *namedQueue) int { return strings.Compare(a.name, b.name) })
+	return queues
+}
+
+// queueShard шард именованной очереди
+type queueShard struct {
+	queue *namedQueue
+	shard int
+}
+
+// allShards возвращает шарды всех очередей: очереди по имени, внутри очереди шарды по порядку
+func (tq *TaskQueue) allShards() []queueShard {
+	var shards []queueShard
+	for _, q := range tq.allQueues() {
+		for shard := 0; shard < q.shards; shard++ {
+			shards = append(shards, queueShard{queue: q, shard: shard})
+		}
+	}
+	return shards
+}
+
+// newTaskID возвращает идентификатор новой задачи очереди. Идентификатор задачи именованной
+// очереди начинается с её имени, чтобы по нему находить очередь и шард без чтения записи
+func (q *namedQueue) newTaskID(id string) string {
+	if q.name == DefaultQueue {
+		return id
+	}
+	return q.name + ":" + id
+}
+
+// locate возвращает очередь и шард задачи по её идентификатору
+func (tq *TaskQueue) locate(taskID string) (*namedQueue, int) {
+	q := tq.queues[DefaultQueue]
+	if name, _, ok := strings.Cut(taskID, ":"); ok {
+		if named, ok := tq.queues[name]; ok {
+			q = named
+		}
+	}
+	return q, q.shard(taskID)
+}
+
+// shard возвращает номер шарда очереди на основе taskID
+func (q *namedQueue) shard(taskID string) int {
+	hash := crc32.ChecksumIEEE([]byte(taskID))
+	return int(hash % uint32(q.shards))
+}
+
+// keys возвращает ключи Redis для шарда очереди. Ключи именованной очереди начинаются с её префикса
+func (tq *TaskQueue) keys(q *namedQueue, shard int) shardKeys {
+	key := func(base string) string {
+		if q.prefix == "" {
+			return fmt.Sprintf("%s:%d", base, shard)
+		}
+		return fmt.Sprintf("%s:%s:%d", q.prefix, base, shard)
+	}
+	return shardKeys{
+		priority:      key(tq.cfg.Queues.PriorityKey),
+		delayed:       key(tq.cfg.Queues.DelayedKey),
+		processing:    key(tq.cfg.Queues.LeaseKey),
+		leases:        key(tq.cfg.Queues.LeaseKey) + ":tokens",
+		notify:        key(tq.cfg.Queues.NotifyKey),
+		delayedNotify: key(tq.cfg.Queues.NotifyKey) + ":delayed",
+		deadLetter:    key(tq.cfg.Queues.DeadLetterKey),
+		scheduler:     key(tq.cfg.Queues.PriorityKey) + ":scheduler",
+		sequence:      key(tq.cfg.Queues.PriorityKey) + ":seq",
+	}
+}
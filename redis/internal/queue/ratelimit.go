@@ -0,0 +1,67 @@
+package queue
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"time"
+
+	"task-queue/internal/config"
+)
+
+// defaultRatePeriod окно ограничения частоты, если period не задан
+const defaultRatePeriod = time.Second
+
+// rateLimit ограничение частоты с ключом Redis, общим для всех реплик
+type rateLimit struct {
+	Key    string `json:"key"`
+	Limit  int    `json:"limit"`
+	Period int64  `json:"period"` // Окно в миллисекундах
+}
+
+// rateLimitSet ограничения частоты, которые claim_task.lua проверяет перед выдачей задачи очереди:
+// ограничение самой очереди и ограничения типов задач
+type rateLimitSet struct {
+	DefaultType string               `json:"default_type"` // Тип задач, поставленных до появления типов
+	Queue       *rateLimit           `json:"queue,omitempty"`
+	Types       map[string]rateLimit `json:"types,omitempty"`
+}
+
+// rateLimitArgs возвращает аргументы claim_task.lua с ограничениями частоты очереди q: их JSON
+// (пустую строку, если ограничений нет) и затравку, от которой скрипт случайно разносит задержки
+// отложенных задач, чтобы они не возвращались все в один момент
+func (tq *TaskQueue) rateLimitArgs(q *namedQueue) []interface{} {
+	set := rateLimitSet{DefaultType: DefaultTaskType}
+	if q.rateLimit.Limit > 0 {
+		limit := tq.newRateLimit("queue:"+q.name, q.rateLimit)
+		set.Queue = &limit
+	}
+	for taskType, typeCfg := range tq.cfg.TaskTypes {
+		if typeCfg.RateLimit.Limit <= 0 {
+			continue
+		}
+		if set.Types == nil {
+			set.Types = make(map[string]rateLimit)
+		}
+		set.Types[taskType] = tq.newRateLimit("type:"+taskType, typeCfg.RateLimit)
+	}
+	if set.Queue == nil && set.Types == nil {
+		return []interface{}{"", 0}
+	}
+
+	// Структура из строк и чисел кодируется всегда
+	limits, _ := json.Marshal(set)
+	return []interface{}{string(limits), rand.Int32()}
+}
+
+// newRateLimit собирает ограничение из конфигурации; name отличает ограничения типов и очередей
+func (tq *TaskQueue) newRateLimit(name string, cfg config.RateLimitConfig) rateLimit {
+	period := defaultRatePeriod
+	if cfg.Period > 0 {
+		period = time.Duration(cfg.Period) * time.Millisecond
+	}
+	return rateLimit{
+		Key:    tq.cfg.Queues.RateLimitKey + ":" + name,
+		Limit:  cfg.Limit,
+		Period: period.Milliseconds(),
+	}
+}
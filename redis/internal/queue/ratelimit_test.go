@@ -0,0 +1,114 @@
+package queue
+
+import (
+	"encoding/json"
+	"testing"
+
+	"task-queue/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskQueue_newRateLimit(t *testing.T) {
+	tq := &TaskQueue{cfg: &config.Config{Queues: config.QueuesConfig{RateLimitKey: "rate_limit"}}}
+
+	tests := []struct {
+		name     string
+		limit    string
+		cfg      config.RateLimitConfig
+		expected rateLimit
+	}{
+		{
+			name:     "Period is taken from config",
+			limit:    "queue:email",
+			cfg:      config.RateLimitConfig{Limit: 10, Period: 60000},
+			expected: rateLimit{Key: "rate_limit:queue:email", Limit: 10, Period: 60000},
+		},
+		{
+			name:     "Empty period defaults to a second",
+			limit:    "type:report",
+			cfg:      config.RateLimitConfig{Limit: 50},
+			expected: rateLimit{Key: "rate_limit:type:report", Limit: 50, Period: 1000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tq.newRateLimit(tt.limit, tt.cfg))
+		})
+	}
+}
+
+func TestTaskQueue_rateLimitArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *config.Config
+		queue    string
+		expected *rateLimitSet
+	}{
+		{
+			name:     "No limits",
+			cfg:      &config.Config{},
+			queue:    DefaultQueue,
+			expected: nil,
+		},
+		{
+			name: "Zero limits are ignored",
+			cfg: &config.Config{
+				TaskTypes:   map[string]config.TaskTypeConfig{"report": {RateLimit: config.RateLimitConfig{Period: 1000}}},
+				NamedQueues: map[string]config.QueueConfig{"email": {RateLimit: config.RateLimitConfig{Period: 1000}}},
+			},
+			queue:    "email",
+			expected: nil,
+		},
+		{
+			name: "Queue limit",
+			cfg: &config.Config{
+				NamedQueues: map[string]config.QueueConfig{"email": {RateLimit: config.RateLimitConfig{Limit: 10, Period: 60000}}},
+			},
+			queue: "email",
+			expected: &rateLimitSet{
+				DefaultType: DefaultTaskType,
+				Queue:       &rateLimit{Key: "rate_limit:queue:email", Limit: 10, Period: 60000},
+			},
+		},
+		{
+			name: "Type limits apply to every queue",
+			cfg: &config.Config{
+				TaskTypes: map[string]config.TaskTypeConfig{
+					"default": {RateLimit: config.RateLimitConfig{Limit: 50}},
+					"report":  {},
+				},
+				NamedQueues: map[string]config.QueueConfig{"email": {RateLimit: config.RateLimitConfig{Limit: 10, Period: 60000}}},
+			},
+			queue: DefaultQueue,
+			expected: &rateLimitSet{
+				DefaultType: DefaultTaskType,
+				Types:       map[string]rateLimit{"default": {Key: "rate_limit:type:default", Limit: 50, Period: 1000}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.Queues.RateLimitKey = "rate_limit"
+			tq := &TaskQueue{cfg: tt.cfg, queues: newQueues(tt.cfg)}
+
+			args := tq.rateLimitArgs(tq.queues[tt.queue])
+			if !assert.Len(t, args, 2) {
+				return
+			}
+			if tt.expected == nil {
+				assert.Equal(t, []interface{}{"", 0}, args)
+				return
+			}
+
+			var set rateLimitSet
+			if !assert.NoError(t, json.Unmarshal([]byte(args[0].(string)), &set)) {
+				return
+			}
+			assert.Equal(t, *tt.expected, set)
+			assert.IsType(t, int32(0), args[1])
+		})
+	}
+}
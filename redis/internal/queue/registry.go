@@ -0,0 +1,62 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// DefaultTaskType тип задачи, если он не указан при добавлении
+const DefaultTaskType = "default"
+
+// ErrUnknownTaskType возвращается, если для типа задачи нет обработчика
+var ErrUnknownTaskType = errors.New("no handler registered for task type")
+
+// Handler выполняет задачу определённого типа и возвращает её результат,
+// который после успешного выполнения доступен через GetResult.
+// Контекст отменяется при остановке воркера, потере аренды задачи или по таймауту (ErrTaskTimeout);
+// долгие обработчики могут продлевать аренду через ExtendLease. Воркер ждёт возврата обработчика
+// и после отмены контекста, поэтому обработчик должен на неё реагировать.
+// Ошибка, обёрнутая в Permanent, сразу отправляет задачу в dead_letter_queue,
+// а RetryAfter откладывает задачу, не расходуя попытку
+type Handler func(ctx context.Context, task Task) (string, error)
+
+// Registry хранит обработчики задач по их типу
+type Registry struct {
+	mu       sync.RWMutex
+	handlers map[string]Handler
+}
+
+// NewRegistry создаёт пустой реестр обработчиков
+func NewRegistry() *Registry {
+	return &Registry{handlers: make(map[string]Handler)}
+}
+
+// Register регистрирует обработчик для типа задачи.
+// Повторная регистрация одного и того же типа считается ошибкой программиста
+func (r *Registry) Register(taskType string, handler Handler) {
+	if taskType == "" {
+		panic("queue: empty task type")
+	}
+	if handler == nil {
+		panic("queue: nil handler for task type " + taskType)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.handlers[taskType]; exists {
+		panic(fmt.Sprintf("queue: handler for task type %q already registered", taskType))
+	}
+	r.handlers[taskType] = handler
+}
+
+// Handler возвращает обработчик для типа задачи
+func (r *Registry) Handler(taskType string) (Handler, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	handler, ok := r.handlers[taskType]
+	return handler, ok
+}
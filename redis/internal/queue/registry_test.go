@@ -0,0 +1,94 @@
+package queue
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry_Register(t *testing.T) {
+	handler := func(ctx context.Context, task Task) (string, error) { return "", nil }
+
+	tests := []struct {
+		name     string
+		taskType string
+		handler  Handler
+		panics   bool
+	}{
+		{
+			name:     "Handler is registered",
+			taskType: "email",
+			handler:  handler,
+			panics:   false,
+		},
+		{
+			name:     "Empty task type",
+			taskType: "",
+			handler:  handler,
+			panics:   true,
+		},
+		{
+			name:     "Nil handler",
+			taskType: "email",
+			handler:  nil,
+			panics:   true,
+		},
+		{
+			name:     "Duplicate task type",
+			taskType: DefaultTaskType,
+			handler:  handler,
+			panics:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := NewRegistry()
+			registry.Register(DefaultTaskType, handler)
+
+			register := func() { registry.Register(tt.taskType, tt.handler) }
+			if tt.panics {
+				assert.Panics(t, register)
+				return
+			}
+			assert.NotPanics(t, register)
+		})
+	}
+}
+
+func TestRegistry_Handler(t *testing.T) {
+	registry := NewRegistry()
+	registry.Register("email", func(ctx context.Context, task Task) (string, error) { return "sent " + task.Payload, nil })
+
+	tests := []struct {
+		name     string
+		taskType string
+		found    bool
+	}{
+		{
+			name:     "Registered type",
+			taskType: "email",
+			found:    true,
+		},
+		{
+			name:     "Unknown type",
+			taskType: "report",
+			found:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, ok := registry.Handler(tt.taskType)
+			assert.Equal(t, tt.found, ok)
+			if !tt.found {
+				assert.Nil(t, handler)
+				return
+			}
+			result, err := handler(context.Background(), Task{Payload: "hello"})
+			assert.NoError(t, err)
+			assert.Equal(t, "sent hello", result)
+		})
+	}
+}
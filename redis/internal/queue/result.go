@@ -0,0 +1,73 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+var (
+	// ErrResultNotReady возвращается, пока задача ещё не выполнена
+	ErrResultNotReady = errors.New("task result is not ready")
+	// ErrTaskFailed возвращается для задачи, ушедшей в dead_letter_queue
+	ErrTaskFailed = errors.New("task failed")
+	// ErrTaskCancelled возвращается для отменённой задачи
+	ErrTaskCancelled = errors.New("task was cancelled")
+	// ErrResultExpired возвращается, если задача выполнена, но её результат уже удалён по result_ttl
+	ErrResultExpired = errors.New("task result expired")
+	// ErrResultNotStored возвращается, если задача выполнена, но результат не сохранялся (result_ttl 0)
+	ErrResultNotStored = errors.New("task result is not stored")
+)
+
+// TaskResult результат выполненной задачи
+type TaskResult struct {
+	ID     string `json:"id"`
+	Result string `json:"result"`
+}
+
+// GetResult возвращает результат, который вернул обработчик задачи. Если результата нет, по записи задачи
+// различает, что задача ещё не выполнена, не сохраняла результат, результат истёк или задача не выполнена вовсе;
+// без записи задача неизвестна
+func (tq *TaskQueue) GetResult(ctx context.Context, taskID string) (*TaskResult, error) {
+	pipe := tq.client.TxPipeline()
+	resultCmd := pipe.Get(ctx, tq.resultKey(taskID))
+	taskCmd := pipe.Get(ctx, tq.taskKey(taskID))
+	if _, err := pipe.Exec(ctx); err != nil && !errors.Is(err, redis.Nil) {
+		tq.logger.Error("Failed to look up task result",
+			zap.String("task_id", taskID),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to look up task result: %w", err)
+	}
+
+	// Результат может пережить запись задачи, если retention короче result_ttl
+	if result, err := resultCmd.Result(); err == nil {
+		return &TaskResult{ID: taskID, Result: result}, nil
+	}
+
+	taskJSON, err := taskCmd.Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrTaskNotFound
+	}
+
+	var task Task
+	if err := json.Unmarshal([]byte(taskJSON), &task); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal task: %w", err)
+	}
+
+	switch {
+	case task.State == TaskStateSucceeded && task.ResultExpiresAt == 0:
+		return nil, ErrResultNotStored
+	case task.State == TaskStateSucceeded:
+		return nil, ErrResultExpired
+	case task.State == TaskStateDead:
+		return nil, ErrTaskFailed
+	case task.State == TaskStateCancelled:
+		return nil, ErrTaskCancelled
+	default:
+		return nil, ErrResultNotReady
+	}
+}
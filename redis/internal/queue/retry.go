@@ -0,0 +1,136 @@
+package queue
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"task-queue/internal/config"
+)
+
+// RetryPolicyType способ вычисления задержки перед повтором
+type RetryPolicyType string
+
+const (
+	RetryExponential RetryPolicyType = "exponential" // Задержка растёт в BackoffFactor раз с каждой попыткой
+	RetryFixed       RetryPolicyType = "fixed"       // Задержки берутся по порядку из Delays
+)
+
+// RetryPolicy политика повторов задачи. Незаданные (нулевые) поля берутся из конфигурации retry
+type RetryPolicy struct {
+	Type           RetryPolicyType `json:"type,omitempty"`
+	MaxAttempts    int             `json:"max_attempts,omitempty"`    // После стольких попыток задача уходит в dead_letter_queue
+	BackoffInitial int             `json:"backoff_initial,omitempty"` // Задержка перед первым повтором, мс
+	BackoffFactor  float64         `json:"backoff_factor,omitempty"`  // Во сколько раз растёт задержка с каждой попыткой
+	BackoffMax     int             `json:"backoff_max,omitempty"`     // Наибольшая задержка для exponential, мс
+	Jitter         float64         `json:"jitter,omitempty"`          // Случайное отклонение задержки, доля от 0 до 1
+	// Задержки повторов по порядку для политики fixed, мс. Если попыток больше,
+	// чем задержек, повторяется последняя; без MaxAttempts попыток на одну больше, чем задержек
+	Delays []int `json:"delays,omitempty"`
+}
+
+// Valid проверяет, что поля политики не выходят за допустимые значения
+func (p RetryPolicy) Valid() bool {
+	switch p.Type {
+	case "", RetryExponential:
+	case RetryFixed:
+		if len(p.Delays) == 0 {
+			return false
+		}
+	default:
+		return false
+	}
+	for _, delay := range p.Delays {
+		if delay < 0 {
+			return false
+		}
+	}
+	return p.MaxAttempts >= 0 && p.BackoffInitial >= 0 && p.BackoffFactor >= 0 &&
+		p.BackoffMax >= 0 && p.Jitter >= 0 && p.Jitter <= 1
+}
+
+// retryPolicy возвращает политику повторов задачи, дополненную значениями из конфигурации её очереди
+func (tq *TaskQueue) retryPolicy(task Task) RetryPolicy {
+	retryCfg := tq.cfg.Retry
+	if q, err := tq.queue(task.Queue); err == nil {
+		retryCfg = q.retry
+	}
+	policy := defaultRetryPolicy(retryCfg)
+	if task.Retry == nil {
+		return policy.withFixedAttempts()
+	}
+	// Политика fixed или задержки задачи не наследуют max_attempts очереди
+	if task.Retry.Type != "" || len(task.Retry.Delays) > 0 {
+		policy.MaxAttempts = 0
+	}
+	if task.Retry.Type != "" {
+		policy.Type = task.Retry.Type
+	}
+	if len(task.Retry.Delays) > 0 {
+		policy.Delays = task.Retry.Delays
+	}
+	if task.Retry.BackoffInitial > 0 {
+		policy.BackoffInitial = task.Retry.BackoffInitial
+	}
+	if task.Retry.BackoffFactor > 0 {
+		policy.BackoffFactor = task.Retry.BackoffFactor
+	}
+	if task.Retry.BackoffMax > 0 {
+		policy.BackoffMax = task.Retry.BackoffMax
+	}
+	if task.Retry.Jitter > 0 {
+		policy.Jitter = task.Retry.Jitter
+	}
+	if task.Retry.MaxAttempts > 0 {
+		policy.MaxAttempts = task.Retry.MaxAttempts
+	}
+	return policy.withFixedAttempts()
+}
+
+// withFixedAttempts для политики fixed без MaxAttempts задаёт попытку на каждую задержку и первую попытку.
+// Заданный MaxAttempts соблюдается: при большем значении повторяется последняя задержка
+func (p RetryPolicy) withFixedAttempts() RetryPolicy {
+	if p.Type == RetryFixed && p.MaxAttempts == 0 {
+		p.MaxAttempts = len(p.Delays) + 1
+	}
+	return p
+}
+
+// defaultRetryPolicy собирает политику повторов из конфигурации
+func defaultRetryPolicy(cfg config.RetryConfig) RetryPolicy {
+	policyType := RetryPolicyType(cfg.Type)
+	if policyType == "" {
+		policyType = RetryExponential
+	}
+	return RetryPolicy{
+		Type:           policyType,
+		MaxAttempts:    cfg.MaxAttempts,
+		BackoffInitial: cfg.BackoffInitial,
+		BackoffFactor:  cfg.BackoffFactor,
+		BackoffMax:     cfg.BackoffMax,
+		Jitter:         cfg.Jitter,
+		Delays:         cfg.Delays,
+	}
+}
+
+// backoff возвращает задержку перед повтором после attempt неудачных попыток:
+// очередную из Delays для fixed или экспоненциальный рост не больше BackoffMax для exponential,
+// со случайным отклонением на ±Jitter
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	var delay float64
+	if p.Type == RetryFixed && len(p.Delays) > 0 {
+		delay = float64(p.Delays[min(attempt, len(p.Delays))-1])
+	} else {
+		delay = float64(p.BackoffInitial) * math.Pow(p.BackoffFactor, float64(attempt-1))
+		if p.BackoffMax > 0 {
+			delay = math.Min(delay, float64(p.BackoffMax))
+		}
+	}
+	// Разносим повторы задач, упавших одновременно, чтобы они не вернулись все в один момент
+	if p.Jitter > 0 {
+		delay *= 1 + p.Jitter*(2*rand.Float64()-1)
+	}
+	// Без ограничения задержка может переполнить time.Duration
+	delay = math.Min(delay, float64(math.MaxInt64/time.Millisecond))
+	return time.Duration(delay) * time.Millisecond
+}
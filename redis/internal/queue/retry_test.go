@@ -0,0 +1,378 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"task-queue/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   RetryPolicy
+		attempt  int
+		expected time.Duration
+	}{
+		{
+			name:     "First retry uses initial delay",
+			policy:   RetryPolicy{Type: RetryExponential, BackoffInitial: 100, BackoffFactor: 2},
+			attempt:  1,
+			expected: 100 * time.Millisecond,
+		},
+		{
+			name:     "Delay grows by factor",
+			policy:   RetryPolicy{Type: RetryExponential, BackoffInitial: 100, BackoffFactor: 2},
+			attempt:  4,
+			expected: 800 * time.Millisecond,
+		},
+		{
+			name:     "Delay is capped by backoff_max",
+			policy:   RetryPolicy{Type: RetryExponential, BackoffInitial: 100, BackoffFactor: 2, BackoffMax: 500},
+			attempt:  10,
+			expected: 500 * time.Millisecond,
+		},
+		{
+			name:     "Delay below backoff_max is not capped",
+			policy:   RetryPolicy{Type: RetryExponential, BackoffInitial: 100, BackoffFactor: 2, BackoffMax: 500},
+			attempt:  3,
+			expected: 400 * time.Millisecond,
+		},
+		{
+			name:     "Factor below one shrinks delay",
+			policy:   RetryPolicy{Type: RetryExponential, BackoffInitial: 1000, BackoffFactor: 0.5},
+			attempt:  3,
+			expected: 250 * time.Millisecond,
+		},
+		{
+			name:     "Empty type is exponential",
+			policy:   RetryPolicy{BackoffInitial: 100, BackoffFactor: 3},
+			attempt:  2,
+			expected: 300 * time.Millisecond,
+		},
+		{
+			name:     "Uncapped delay does not overflow",
+			policy:   RetryPolicy{Type: RetryExponential, BackoffInitial: 1000, BackoffFactor: 10},
+			attempt:  100,
+			expected: time.Duration(1<<63 - 1).Truncate(time.Millisecond),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.policy.backoff(tt.attempt))
+		})
+	}
+}
+
+func TestRetryPolicy_backoffFixed(t *testing.T) {
+	policy := RetryPolicy{Type: RetryFixed, MaxAttempts: 6, Delays: []int{60000, 300000, 900000}}
+
+	tests := []struct {
+		name     string
+		attempt  int
+		expected time.Duration
+	}{
+		{
+			name:     "First retry uses first delay",
+			attempt:  1,
+			expected: time.Minute,
+		},
+		{
+			name:     "Retries use delays in order",
+			attempt:  2,
+			expected: 5 * time.Minute,
+		},
+		{
+			name:     "Last delay",
+			attempt:  3,
+			expected: 15 * time.Minute,
+		},
+		{
+			name:     "Last delay repeats when max_attempts is larger",
+			attempt:  5,
+			expected: 15 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, policy.backoff(tt.attempt))
+		})
+	}
+}
+
+func TestRetryPolicy_withFixedAttempts(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   RetryPolicy
+		expected int
+	}{
+		{
+			name:     "Fixed policy has attempt per delay and first attempt",
+			policy:   RetryPolicy{Type: RetryFixed, Delays: []int{60000, 300000, 900000}},
+			expected: 4,
+		},
+		{
+			name:     "Fixed policy keeps explicit max_attempts",
+			policy:   RetryPolicy{Type: RetryFixed, MaxAttempts: 10, Delays: []int{60000, 300000, 900000}},
+			expected: 10,
+		},
+		{
+			name:     "Fixed policy with single delay",
+			policy:   RetryPolicy{Type: RetryFixed, Delays: []int{1000}},
+			expected: 2,
+		},
+		{
+			name:     "Exponential policy keeps max_attempts",
+			policy:   RetryPolicy{Type: RetryExponential, MaxAttempts: 10, Delays: []int{1000}},
+			expected: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.policy.withFixedAttempts().MaxAttempts)
+		})
+	}
+}
+
+func TestRetryPolicy_backoffJitter(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		min    time.Duration
+		max    time.Duration
+	}{
+		{
+			name:   "Jitter spreads delay around base",
+			policy: RetryPolicy{Type: RetryExponential, BackoffInitial: 1000, BackoffFactor: 2, Jitter: 0.2},
+			min:    800 * time.Millisecond,
+			max:    1200 * time.Millisecond,
+		},
+		{
+			name:   "Jitter applies after backoff_max",
+			policy: RetryPolicy{Type: RetryExponential, BackoffInitial: 5000, BackoffFactor: 2, BackoffMax: 2000, Jitter: 0.5},
+			min:    1000 * time.Millisecond,
+			max:    3000 * time.Millisecond,
+		},
+		{
+			name:   "Full jitter never goes negative",
+			policy: RetryPolicy{Type: RetryExponential, BackoffInitial: 1000, BackoffFactor: 1, Jitter: 1},
+			min:    0,
+			max:    2000 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spread bool
+			first := tt.policy.backoff(1)
+			for i := 0; i < 1000; i++ {
+				delay := tt.policy.backoff(1)
+				assert.GreaterOrEqual(t, delay, tt.min)
+				assert.LessOrEqual(t, delay, tt.max)
+				spread = spread || delay != first
+			}
+			assert.True(t, spread, "jitter should vary the delay")
+		})
+	}
+}
+
+func TestRetryPolicy_Valid(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   RetryPolicy
+		expected bool
+	}{
+		{
+			name:     "Empty policy",
+			policy:   RetryPolicy{},
+			expected: true,
+		},
+		{
+			name:     "Exponential policy",
+			policy:   RetryPolicy{Type: RetryExponential, MaxAttempts: 5, BackoffInitial: 100, BackoffFactor: 0.5, BackoffMax: 1000, Jitter: 1},
+			expected: true,
+		},
+		{
+			name:     "Unknown type",
+			policy:   RetryPolicy{Type: "linear"},
+			expected: false,
+		},
+		{
+			name:     "Negative max_attempts",
+			policy:   RetryPolicy{MaxAttempts: -1},
+			expected: false,
+		},
+		{
+			name:     "Negative backoff_initial",
+			policy:   RetryPolicy{BackoffInitial: -1},
+			expected: false,
+		},
+		{
+			name:     "Negative backoff_factor",
+			policy:   RetryPolicy{BackoffFactor: -2},
+			expected: false,
+		},
+		{
+			name:     "Negative backoff_max",
+			policy:   RetryPolicy{BackoffMax: -1},
+			expected: false,
+		},
+		{
+			name:     "Negative jitter",
+			policy:   RetryPolicy{Jitter: -0.1},
+			expected: false,
+		},
+		{
+			name:     "Jitter above one",
+			policy:   RetryPolicy{Jitter: 1.5},
+			expected: false,
+		},
+		{
+			name:     "Fixed policy",
+			policy:   RetryPolicy{Type: RetryFixed, Delays: []int{0, 60000}},
+			expected: true,
+		},
+		{
+			name:     "Fixed policy without delays",
+			policy:   RetryPolicy{Type: RetryFixed, MaxAttempts: 3},
+			expected: false,
+		},
+		{
+			name:     "Fixed policy with negative delay",
+			policy:   RetryPolicy{Type: RetryFixed, Delays: []int{1000, -1}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.policy.Valid())
+		})
+	}
+}
+
+func TestMergeRetryConfig(t *testing.T) {
+	base := config.RetryConfig{
+		Type:           "exponential",
+		MaxAttempts:    3,
+		BackoffInitial: 100,
+		BackoffFactor:  2,
+		BackoffMax:     300000,
+		Jitter:         0.2,
+		Delays:         []int{1000},
+	}
+
+	tests := []struct {
+		name     string
+		override config.RetryConfig
+		expected config.RetryConfig
+	}{
+		{
+			name:     "Empty override keeps base",
+			override: config.RetryConfig{},
+			expected: base,
+		},
+		{
+			name:     "Override replaces set fields",
+			override: config.RetryConfig{MaxAttempts: 5, BackoffFactor: 0.5, Jitter: 0.1},
+			expected: config.RetryConfig{
+				Type:           "exponential",
+				MaxAttempts:    5,
+				BackoffInitial: 100,
+				BackoffFactor:  0.5,
+				BackoffMax:     300000,
+				Jitter:         0.1,
+				Delays:         []int{1000},
+			},
+		},
+		{
+			name:     "Override switches policy type without inheriting max_attempts",
+			override: config.RetryConfig{Type: "fixed", Delays: []int{60000, 600000}},
+			expected: config.RetryConfig{
+				Type:           "fixed",
+				MaxAttempts:    0,
+				BackoffInitial: 100,
+				BackoffFactor:  2,
+				BackoffMax:     300000,
+				Jitter:         0.2,
+				Delays:         []int{60000, 600000},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, mergeRetryConfig(base, tt.override))
+		})
+	}
+}
+
+func TestTaskQueue_retryPolicy(t *testing.T) {
+	cfg := &config.Config{
+		Retry: config.RetryConfig{MaxAttempts: 3, BackoffInitial: 100, BackoffFactor: 2, Jitter: 0.2},
+		NamedQueues: map[string]config.QueueConfig{
+			"email":    {Retry: config.RetryConfig{MaxAttempts: 5, BackoffMax: 1000}},
+			"billing":  {Retry: config.RetryConfig{Type: "fixed", Delays: []int{60000, 600000}}},
+			"invoices": {Retry: config.RetryConfig{Type: "fixed", Delays: []int{60000, 600000}, MaxAttempts: 6}},
+		},
+	}
+	tq := &TaskQueue{cfg: cfg, queues: newQueues(cfg)}
+
+	tests := []struct {
+		name     string
+		task     Task
+		expected RetryPolicy
+	}{
+		{
+			name:     "Default queue uses retry config",
+			task:     Task{},
+			expected: RetryPolicy{Type: RetryExponential, MaxAttempts: 3, BackoffInitial: 100, BackoffFactor: 2, Jitter: 0.2},
+		},
+		{
+			name:     "Named queue merges its retry with retry config",
+			task:     Task{Queue: "email"},
+			expected: RetryPolicy{Type: RetryExponential, MaxAttempts: 5, BackoffInitial: 100, BackoffFactor: 2, BackoffMax: 1000, Jitter: 0.2},
+		},
+		{
+			name:     "Fixed queue policy gets attempt per delay",
+			task:     Task{Queue: "billing"},
+			expected: RetryPolicy{Type: RetryFixed, MaxAttempts: 3, BackoffInitial: 100, BackoffFactor: 2, Jitter: 0.2, Delays: []int{60000, 600000}},
+		},
+		{
+			name:     "Fixed queue policy keeps its max_attempts",
+			task:     Task{Queue: "invoices"},
+			expected: RetryPolicy{Type: RetryFixed, MaxAttempts: 6, BackoffInitial: 100, BackoffFactor: 2, Jitter: 0.2, Delays: []int{60000, 600000}},
+		},
+		{
+			name:     "Task delays do not inherit queue max_attempts",
+			task:     Task{Queue: "invoices", Retry: &RetryPolicy{Delays: []int{1000, 2000, 3000}}},
+			expected: RetryPolicy{Type: RetryFixed, MaxAttempts: 4, BackoffInitial: 100, BackoffFactor: 2, Jitter: 0.2, Delays: []int{1000, 2000, 3000}},
+		},
+		{
+			name:     "Task max_attempts extends fixed policy",
+			task:     Task{Queue: "billing", Retry: &RetryPolicy{MaxAttempts: 5}},
+			expected: RetryPolicy{Type: RetryFixed, MaxAttempts: 5, BackoffInitial: 100, BackoffFactor: 2, Jitter: 0.2, Delays: []int{60000, 600000}},
+		},
+		{
+			name:     "Task delays replace queue delays",
+			task:     Task{Queue: "billing", Retry: &RetryPolicy{Delays: []int{1000}}},
+			expected: RetryPolicy{Type: RetryFixed, MaxAttempts: 2, BackoffInitial: 100, BackoffFactor: 2, Jitter: 0.2, Delays: []int{1000}},
+		},
+		{
+			name:     "Task policy overrides queue policy",
+			task:     Task{Queue: "email", Retry: &RetryPolicy{MaxAttempts: 2, BackoffFactor: 0.5}},
+			expected: RetryPolicy{Type: RetryExponential, MaxAttempts: 2, BackoffInitial: 100, BackoffFactor: 0.5, BackoffMax: 1000, Jitter: 0.2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tq.retryPolicy(tt.task))
+		})
+	}
+}
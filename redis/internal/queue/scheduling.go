@@ -0,0 +1,24 @@
+package queue
+
+// schedulingArgs возвращает аргументы claim_task.lua, задающие порядок выбора задачи
+// (scheduling.mode): strict — всегда наибольший приоритет, weighted — уровни по очереди
+// пропорционально весам, aging — приоритет ждущей задачи растёт со временем ожидания.
+// Веса передаются для всех уровней от priorities.high к priorities.low; без весов уровни равны
+func (tq *TaskQueue) schedulingArgs() []interface{} {
+	cfg := tq.cfg.Scheduling
+	mode := cfg.Mode
+	if mode == "" {
+		mode = "strict"
+	}
+	high, low := tq.cfg.Priorities.High, tq.cfg.Priorities.Low
+
+	args := []interface{}{mode, high, low, cfg.AgingInterval}
+	if mode != "weighted" || len(cfg.Weights) == 0 {
+		return args
+	}
+	for i := 0; i <= high-low; i++ {
+		// Уровням за концом списка достаётся последний вес
+		args = append(args, cfg.Weights[min(i, len(cfg.Weights)-1)])
+	}
+	return args
+}
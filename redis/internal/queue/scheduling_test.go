@@ -0,0 +1,62 @@
+package queue
+
+import (
+	"testing"
+
+	"task-queue/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskQueue_schedulingArgs(t *testing.T) {
+	priorities := config.PrioritiesConfig{Low: 1, Medium: 2, High: 3}
+
+	tests := []struct {
+		name       string
+		scheduling config.SchedulingConfig
+		expected   []interface{}
+	}{
+		{
+			name:       "Empty mode is strict",
+			scheduling: config.SchedulingConfig{},
+			expected:   []interface{}{"strict", 3, 1, 0},
+		},
+		{
+			name:       "Aging passes its interval",
+			scheduling: config.SchedulingConfig{Mode: "aging", AgingInterval: 60000},
+			expected:   []interface{}{"aging", 3, 1, 60000},
+		},
+		{
+			name:       "Weights are ignored outside weighted",
+			scheduling: config.SchedulingConfig{Mode: "strict", Weights: []int{5, 3, 1}},
+			expected:   []interface{}{"strict", 3, 1, 0},
+		},
+		{
+			name:       "Weighted without weights keeps levels equal",
+			scheduling: config.SchedulingConfig{Mode: "weighted"},
+			expected:   []interface{}{"weighted", 3, 1, 0},
+		},
+		{
+			name:       "Weight per level",
+			scheduling: config.SchedulingConfig{Mode: "weighted", Weights: []int{5, 3, 1}},
+			expected:   []interface{}{"weighted", 3, 1, 0, 5, 3, 1},
+		},
+		{
+			name:       "Levels past the list get the last weight",
+			scheduling: config.SchedulingConfig{Mode: "weighted", Weights: []int{5, 2}},
+			expected:   []interface{}{"weighted", 3, 1, 0, 5, 2, 2},
+		},
+		{
+			name:       "Extra weights are dropped",
+			scheduling: config.SchedulingConfig{Mode: "weighted", Weights: []int{5, 3, 1, 1}},
+			expected:   []interface{}{"weighted", 3, 1, 0, 5, 3, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tq := &TaskQueue{cfg: &config.Config{Priorities: priorities, Scheduling: tt.scheduling}}
+			assert.Equal(t, tt.expected, tq.schedulingArgs())
+		})
+	}
+}
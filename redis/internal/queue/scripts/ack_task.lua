@@ -0,0 +1,93 @@
+-- ack_task.lua
+-- ARGV[1]: taskID (идентификатор задачи)
+-- ARGV[2]: leaseToken (токен аренды, выданный при взятии задачи)
+-- ARGV[3]: action (done — выполнена, retry — в delayed_queue, defer — в delayed_queue без неудачной попытки, dead — в dead_letter_queue)
+-- ARGV[4]: updatedJSON (обновлённая JSON-строка задачи)
+-- ARGV[5]: score (время повтора в миллисекундах для retry и defer)
+-- ARGV[6]: retention (сколько хранить запись выполненной задачи в миллисекундах, 0 — удалить сразу; при сохранённом результате не меньше 2 × resultTTL)
+-- ARGV[7]: result (результат обработчика для done)
+-- ARGV[8]: resultTTL (сколько хранить результат в миллисекундах, 0 — не сохранять)
+-- KEYS[1]: processing_leases (ключ очереди задач в обработке)
+-- KEYS[2]: leases (хэш токенов аренды задач в обработке)
+-- KEYS[3]: delayed_queue (ключ отложенной очереди)
+-- KEYS[4]: dead_letter_queue (ключ очереди недоставленных задач)
+-- KEYS[5]: delayed_notify (ключ списка сигналов о новых отложенных задачах)
+-- KEYS[6]: task (ключ записи задачи)
+-- KEYS[7]: result (ключ результата задачи)
+
+local taskID = ARGV[1]
+
+-- Аренда истекла, и задачу уже вернул reaper или взял другой воркер
+if redis.call('HGET', KEYS[2], taskID) ~= ARGV[2] then
+    return 0
+end
+
+redis.call('ZREM', KEYS[1], taskID)
+redis.call('HDEL', KEYS[2], taskID)
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local action = ARGV[3]
+local taskJSON = ARGV[4]
+local ok, task = pcall(cjson.decode, taskJSON)
+if not ok or type(task) ~= 'table' then
+    -- Повреждённую запись сохраняем как есть, без переходов
+    task = nil
+end
+
+-- Завершённая задача больше не мешает добавить задачу с тем же ключом уникальности
+if task and action ~= 'retry' and action ~= 'defer' then
+    release(task)
+end
+
+if action == 'done' then
+    local resultTTL = tonumber(ARGV[8]) or 0
+    local retention = tonumber(ARGV[6]) or 0
+    if resultTTL > 0 then
+        redis.call('SET', KEYS[7], ARGV[7], 'PX', resultTTL)
+        -- Запись переживает результат на result_ttl, чтобы истёкший результат можно было
+        -- отличить от неизвестной задачи даже при коротком retention
+        retention = math.max(retention, 2 * resultTTL)
+    end
+
+    if retention <= 0 then
+        redis.call('DEL', KEYS[6])
+        return 1
+    end
+    if task then
+        transition(task, 'succeeded', now)
+        -- Без времени удаления результата запись означает, что результат не сохранялся
+        if resultTTL > 0 then
+            task.result_expires_at = now + resultTTL
+        end
+        taskJSON = cjson.encode(task)
+    end
+    -- Запись выполненной задачи хранится retention, после чего Redis удаляет её сам
+    redis.call('SET', KEYS[6], taskJSON, 'PX', retention)
+elseif action == 'retry' or action == 'defer' then
+    if task then
+        -- Отложенная обработчиком задача не считается упавшей
+        if action == 'retry' then
+            task.failed_at = now
+            transition(task, 'failed', now)
+        end
+        transition(task, 'retrying', now)
+        taskJSON = cjson.encode(task)
+    end
+    redis.call('SET', KEYS[6], taskJSON)
+    redis.call('ZADD', KEYS[3], tonumber(ARGV[5]), taskID)
+    redis.call('LPUSH', KEYS[5], 1)
+    redis.call('LTRIM', KEYS[5], 0, 0)
+elseif action == 'dead' then
+    if task then
+        task.failed_at = now
+        transition(task, 'failed', now)
+        transition(task, 'dead', now)
+        taskJSON = cjson.encode(task)
+    end
+    redis.call('SET', KEYS[6], taskJSON)
+    redis.call('LPUSH', KEYS[4], taskID)
+end
+
+return 1
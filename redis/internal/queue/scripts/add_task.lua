@@ -1,14 +1,27 @@
 -- add_task.lua
--- ARGV[1]: taskJSON (JSON-строка задачи)
--- ARGV[2]: priority (целочисленный приоритет)
--- ARGV[3]: executeAt (Unix-время выполнения, 0 для немедленных задач)
+-- ARGV[1]: taskID (идентификатор задачи)
+-- ARGV[2]: taskJSON (JSON-строка задачи)
+-- ARGV[3]: priority (целочисленный приоритет)
+-- ARGV[4]: executeAt (Unix-время выполнения в миллисекундах, 0 для немедленных задач)
+-- ARGV[5]: idempotencyTTL (сколько помнить ключ идемпотентности в миллисекундах)
+-- ARGV[6]: taskKeyPrefix (префикс ключей записей задач)
+-- ARGV[7]: uniqueFor (окно уникальности в миллисекундах, 0 — до завершения задачи)
 -- KEYS[1]: priority_queue (ключ приоритетной очереди)
 -- KEYS[2]: delayed_queue (ключ отложенной очереди)
+-- KEYS[3]: notify_queue (ключ списка сигналов о новых задачах)
+-- KEYS[4]: delayed_notify (ключ списка сигналов о новых отложенных задачах)
+-- KEYS[5]: task (ключ записи задачи)
+-- KEYS[6]: idempotency (ключ идемпотентности, пустая строка — без него)
+-- KEYS[7]: unique (ключ блокировки уникальности, пустая строка — без неё)
+-- KEYS[8]: sequence (счётчик постановок в priority_queue)
+-- Возвращает {1 — задача добавлена или 0 — найдена уже добавленная задача, ID задачи, JSON-строка задачи}
 
-local taskJSON = ARGV[1]
-local priority = tonumber(ARGV[2])
-local executeAt = tonumber(ARGV[3]) or 0
-local now = tonumber(redis.call('TIME')[1])
+local taskID = ARGV[1]
+local task = cjson.decode(ARGV[2])
+local priority = tonumber(ARGV[3])
+local executeAt = tonumber(ARGV[4]) or 0
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
 
 if not executeAt then
     return redis.error_reply("Invalid executeAt: not a number")
@@ -18,12 +31,57 @@ if not now then
     return redis.error_reply("Invalid current time: not a number")
 end
 
+-- Повторный запрос с тем же ключом идемпотентности возвращает исходную задачу
+if KEYS[6] ~= '' then
+    local existingID = redis.call('GET', KEYS[6])
+    if existingID then
+        return {0, existingID, redis.call('GET', ARGV[6] .. existingID)}
+    end
+end
+
+-- Пока задача с тем же ключом уникальности не завершена, новая с ней сливается
+if KEYS[7] ~= '' then
+    local existingID = redis.call('GET', KEYS[7])
+    if existingID then
+        local existingJSON = redis.call('GET', ARGV[6] .. existingID)
+        if active(existingJSON) then
+            if KEYS[6] ~= '' then
+                redis.call('SET', KEYS[6], existingID, 'PX', tonumber(ARGV[5]))
+            end
+            return {0, existingID, existingJSON}
+        end
+    end
+
+    local uniqueFor = tonumber(ARGV[7]) or 0
+    if uniqueFor > 0 then
+        redis.call('SET', KEYS[7], taskID, 'PX', uniqueFor)
+    else
+        redis.call('SET', KEYS[7], taskID)
+    end
+end
+
+if KEYS[6] ~= '' then
+    redis.call('SET', KEYS[6], taskID, 'PX', tonumber(ARGV[5]))
+end
+
 if executeAt == 0 or executeAt <= now then
     -- Немедленная задача: добавляем в priority_queue
-    redis.call('ZADD', KEYS[1], priority, taskJSON)
+    transition(task, 'queued', now)
+    task.queued_at = now
+    redis.call('ZADD', KEYS[1], score(KEYS[8], priority), taskID)
+    -- Будим воркера, ожидающего задачи; хватает одного сигнала
+    redis.call('LPUSH', KEYS[3], 1)
+    redis.call('LTRIM', KEYS[3], 0, 0)
 else
     -- Отложенная задача: добавляем в delayed_queue
-    redis.call('ZADD', KEYS[2], executeAt, taskJSON)
+    transition(task, 'scheduled', now)
+    redis.call('ZADD', KEYS[2], executeAt, taskID)
+    -- Будим перенос отложенных задач, чтобы он пересчитал время ожидания
+    redis.call('LPUSH', KEYS[4], 1)
+    redis.call('LTRIM', KEYS[4], 0, 0)
 end
 
-return 1
\ No newline at end of file
+-- Очереди хранят только идентификаторы, сама задача лежит в отдельном ключе
+local taskJSON = cjson.encode(task)
+redis.call('SET', KEYS[5], taskJSON)
+return {1, taskID, taskJSON}
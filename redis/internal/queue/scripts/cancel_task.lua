@@ -0,0 +1,42 @@
+-- cancel_task.lua
+-- ARGV[1]: taskID (идентификатор задачи)
+-- ARGV[2]: retention (сколько хранить запись отменённой задачи в миллисекундах, 0 — удалить сразу)
+-- KEYS[1]: priority_queue (ключ приоритетной очереди)
+-- KEYS[2]: delayed_queue (ключ отложенной очереди)
+-- KEYS[3]: task (ключ записи задачи)
+-- Возвращает {1 — задача отменена или 0, состояние задачи, JSON-строка задачи}
+
+local taskID = ARGV[1]
+local taskJSON = redis.call('GET', KEYS[3])
+if not taskJSON then
+    return {0, 'not_found', false}
+end
+
+local ok, task = pcall(cjson.decode, taskJSON)
+if not ok or type(task) ~= 'table' then
+    task = nil
+end
+
+-- Отменить можно только задачу, которая ещё ждёт воркера
+local removed = redis.call('ZREM', KEYS[1], taskID) + redis.call('ZREM', KEYS[2], taskID)
+if removed == 0 then
+    return {0, task and task.state or 'unknown', taskJSON}
+end
+
+if task then
+    release(task)
+end
+
+local retention = tonumber(ARGV[2]) or 0
+if retention <= 0 or not task then
+    redis.call('DEL', KEYS[3])
+    return {1, 'cancelled', taskJSON}
+end
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+transition(task, 'cancelled', now)
+taskJSON = cjson.encode(task)
+redis.call('SET', KEYS[3], taskJSON, 'PX', retention)
+return {1, 'cancelled', taskJSON}
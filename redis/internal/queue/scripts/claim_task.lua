@@ -0,0 +1,264 @@
+-- claim_task.lua
+-- ARGV[1]: visibilityTimeout (длительность аренды задачи в миллисекундах)
+-- ARGV[2]: leaseToken (уникальный токен аренды, которым воркер подтверждает владение задачей)
+-- ARGV[3]: taskKeyPrefix (префикс ключей записей задач)
+-- ARGV[4]: worker (идентификатор воркера, записываемый в историю попыток)
+-- ARGV[5]: historyLimit (сколько последних попыток хранить в записи задачи)
+-- ARGV[6]: queuePauseField (поле паузы очереди в хэше paused)
+-- ARGV[7]: shardPauseField (поле паузы шарда в хэше paused)
+-- ARGV[8]: rateLimits (JSON ограничений частоты очереди и типов задач, пустая строка — ограничений нет)
+-- ARGV[9]: rateSeed (затравка случайного разброса задержек задач, упёршихся в ограничение частоты)
+-- ARGV[10]: deferLimit (сколько упёршихся в ограничение задач отложить за один вызов)
+-- ARGV[11]: mode (порядок выбора уровня приоритета: strict, weighted или aging)
+-- ARGV[12]: high (наивысший приоритет)
+-- ARGV[13]: low (наименьший приоритет)
+-- ARGV[14]: agingInterval (за сколько ожидания приоритет в aging растёт на единицу, мс)
+-- ARGV[15..]: weights (веса уровней для weighted от high к low)
+-- KEYS[1]: priority_queue (ключ приоритетной очереди)
+-- KEYS[2]: processing_leases (ключ очереди задач в обработке, score — дедлайн аренды в мс)
+-- KEYS[3]: leases (хэш токенов аренды задач в обработке)
+-- KEYS[4]: notify_queue (ключ списка сигналов о новых задачах)
+-- KEYS[5]: scheduler (хэш текущих весов уровней для weighted)
+-- KEYS[6]: paused (хэш приостановленных очередей и шардов)
+-- KEYS[7]: delayed_queue (ключ отложенной очереди)
+-- KEYS[8]: delayed_notify (ключ списка сигналов о новых отложенных задачах)
+-- Возвращает {ID задачи, JSON-строка задачи, сколько задача ждала в priority_queue, мс, сколько задач отложено
+-- из-за ограничения частоты}, {'', '', 0, отложено}, если выдать нечего, но задачи откладывались,
+-- false, если очередь пуста, или пустой массив, если очередь или шард приостановлены
+
+-- startAttempt записывает в историю начало попытки, оставляя не больше limit последних
+local function startAttempt(task, at, worker, limit)
+    if limit <= 0 then
+        return
+    end
+    if type(task.history) ~= 'table' then
+        task.history = {}
+    end
+    table.insert(task.history, {started_at = at, duration = 0, worker = worker})
+    while #task.history > limit do
+        table.remove(task.history, 1)
+    end
+end
+
+local visibilityTimeout = tonumber(ARGV[1])
+if not visibilityTimeout then
+    return redis.error_reply("Invalid visibilityTimeout: not a number")
+end
+
+local mode = ARGV[11]
+local high = tonumber(ARGV[12]) or 0
+local low = tonumber(ARGV[13]) or 0
+local agingInterval = tonumber(ARGV[14]) or 0
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+-- head возвращает задачу уровня, которую воркер взял бы первой, или nil, если уровень пуст
+local function head(priority)
+    local min, max = levelRange(priority)
+    return redis.call('ZREVRANGEBYSCORE', KEYS[1], max, min, 'LIMIT', 0, 1)[1]
+end
+
+-- queuedAt возвращает, с какого момента задача ждёт в priority_queue
+local function queuedAt(task)
+    if type(task) == 'table' and tonumber(task.queued_at) then
+        return tonumber(task.queued_at)
+    end
+    return now
+end
+
+-- selectWeighted выбирает уровень плавным взвешенным round robin среди непустых уровней:
+-- за каждые sum(weights) взятий уровень получает столько, каков его вес. Текущие веса хранятся
+-- в KEYS[5], поэтому очерёдность общая для всех воркеров шарда. Вместе с задачей возвращает новые
+-- текущие веса: их записывает charge, только если задача выдана воркеру
+local function selectWeighted()
+    local total, best = 0, nil
+    local current = {}
+    for i = 0, high - low do
+        local priority = high - i
+        local min, max = levelRange(priority)
+        if redis.call('ZCOUNT', KEYS[1], min, max) > 0 then
+            local weight = tonumber(ARGV[15 + i]) or 1
+            current[priority] = (tonumber(redis.call('HGET', KEYS[5], priority)) or 0) + weight
+            total = total + weight
+            if not best or current[priority] > current[best] then
+                best = priority
+            end
+        else
+            -- Опустевший уровень не копит очередь, иначе потом получит несколько задач подряд
+            redis.call('HDEL', KEYS[5], priority)
+        end
+    end
+    if not best then
+        return nil
+    end
+    current[best] = current[best] - total
+    return head(best), current
+end
+
+-- charge записывает текущие веса уровней после выдачи задачи, выбранной selectWeighted
+local function charge(current)
+    if not current then
+        return
+    end
+    for priority, value in pairs(current) do
+        redis.call('HSET', KEYS[5], priority, value)
+    end
+end
+
+-- selectAged выбирает первую задачу уровня с наибольшим приоритетом с учётом ожидания:
+-- за каждые agingInterval ожидания приоритет растёт на единицу. При равенстве побеждает исходно старший
+local function selectAged()
+    local best, bestPriority = nil, nil
+    for priority = high, low, -1 do
+        local taskID = head(priority)
+        if taskID then
+            local ok, task = pcall(cjson.decode, redis.call('GET', ARGV[3] .. taskID) or '')
+            local effective = priority + math.floor((now - queuedAt(ok and task)) / agingInterval)
+            if not best or effective > bestPriority then
+                best, bestPriority = taskID, effective
+            end
+        end
+    end
+    return best
+end
+
+-- pop извлекает следующую задачу и, в режиме weighted, текущие веса уровней для charge.
+-- Задачи вне уровней low..high и режим strict обслуживаются строго по score
+local function pop()
+    local taskID, current
+    if mode == 'weighted' then
+        taskID, current = selectWeighted()
+    elseif mode == 'aging' and agingInterval > 0 then
+        taskID = selectAged()
+    end
+    if taskID then
+        redis.call('ZREM', KEYS[1], taskID)
+        return taskID, current
+    end
+    return redis.call('ZPOPMAX', KEYS[1])[1]
+end
+
+local rateLimits
+if ARGV[8] ~= '' then
+    rateLimits = cjson.decode(ARGV[8])
+    math.randomseed(tonumber(ARGV[9]) or 0)
+end
+
+-- limitsOf возвращает ограничения частоты, которые действуют на задачу: её типа и её очереди
+local function limitsOf(task)
+    local limits = {}
+    if not rateLimits then
+        return limits
+    end
+    local taskType = task.type
+    if type(taskType) ~= 'string' or taskType == '' then
+        taskType = rateLimits.default_type
+    end
+    if type(rateLimits.types) == 'table' and rateLimits.types[taskType] then
+        table.insert(limits, rateLimits.types[taskType])
+    end
+    if rateLimits.queue then
+        table.insert(limits, rateLimits.queue)
+    end
+    return limits
+end
+
+-- rateLimitDelay проверяет ограничения частоты скользящим окном: за любые period миллисекунд ограничение
+-- пропускает не больше limit задач. Если пропускают все, записывает запуск в каждое и возвращает 0,
+-- иначе — на сколько отложить задачу: до освобождения места в окне плюс случайная доля окна
+local function rateLimitDelay(limits)
+    local wait, period = 0, 0
+    for _, limit in ipairs(limits) do
+        redis.call('ZREMRANGEBYSCORE', limit.key, '-inf', now - limit.period)
+        if redis.call('ZCARD', limit.key) >= limit.limit then
+            -- Место освободится, когда из окна выйдет limit-й с конца запуск
+            local oldest = redis.call('ZRANGE', limit.key, -limit.limit, -limit.limit, 'WITHSCORES')
+            wait = math.max(wait, tonumber(oldest[2]) + limit.period - now)
+        end
+        period = math.max(period, limit.period)
+    end
+    -- Отложенная задача не расходует ни одно ограничение
+    if wait > 0 then
+        return wait + math.random(0, period - 1)
+    end
+    for _, limit in ipairs(limits) do
+        redis.call('ZADD', limit.key, now, ARGV[2])
+        redis.call('PEXPIRE', limit.key, limit.period)
+    end
+    return 0
+end
+
+-- wakeNext будит следующего ожидающего воркера, если в очереди остались задачи
+local function wakeNext()
+    if redis.call('ZCARD', KEYS[1]) > 0 then
+        redis.call('LPUSH', KEYS[4], 1)
+        redis.call('LTRIM', KEYS[4], 0, 0)
+    end
+end
+
+-- Приостановленный шард не отдаёт задачи, но добавление и перенос отложенных задач продолжаются
+if redis.call('HEXISTS', KEYS[6], ARGV[6]) == 1 or redis.call('HEXISTS', KEYS[6], ARGV[7]) == 1 then
+    return {}
+end
+
+local deferLimit = tonumber(ARGV[10]) or 0
+local deferred = 0
+
+while true do
+    -- За вызов откладывается не больше deferLimit задач, чтобы длинная очередь упёршихся в ограничение
+    -- задач не держала Redis; за остальными воркер вернётся сразу
+    if deferred > 0 and deferred >= deferLimit then
+        wakeNext()
+        return {'', '', '0', tostring(deferred)}
+    end
+
+    local taskID, current = pop()
+    if not taskID then
+        if deferred > 0 then
+            return {'', '', '0', tostring(deferred)}
+        end
+        return false
+    end
+
+    local taskKey = ARGV[3] .. taskID
+    local taskJSON = redis.call('GET', taskKey)
+    -- Если запись задачи пропала, её идентификатор просто отбрасывается
+    if taskJSON then
+        -- Повреждённую запись возвращаем как есть: воркер отправит её в dead_letter_queue
+        local ok, task = pcall(cjson.decode, taskJSON)
+        local valid = ok and type(task) == 'table'
+        local delay = 0
+        if valid then
+            delay = rateLimitDelay(limitsOf(task))
+        end
+
+        if delay > 0 then
+            -- Задача, упёршаяся в ограничение частоты, уходит в delayed_queue, не начиная попытку и не расходуя
+            -- вес уровня: история и переходы не меняются, а отметка rate_limited сохраняет при возврате
+            -- в priority_queue прежний queued_at, чтобы ожидание задачи продолжало учитываться
+            task.rate_limited = true
+            redis.call('SET', taskKey, cjson.encode(task))
+            redis.call('ZADD', KEYS[7], now + delay, taskID)
+            redis.call('LPUSH', KEYS[8], 1)
+            redis.call('LTRIM', KEYS[8], 0, 0)
+            deferred = deferred + 1
+        else
+            local waited = 0
+            if valid then
+                waited = now - queuedAt(task)
+                transition(task, 'running', now)
+                startAttempt(task, now, ARGV[4], tonumber(ARGV[5]) or 0)
+                taskJSON = cjson.encode(task)
+                redis.call('SET', taskKey, taskJSON)
+            end
+
+            redis.call('ZADD', KEYS[2], now + visibilityTimeout, taskID)
+            redis.call('HSET', KEYS[3], taskID, ARGV[2])
+            charge(current)
+            wakeNext()
+
+            return {taskID, taskJSON, tostring(waited), tostring(deferred)}
+        end
+    end
+end
@@ -0,0 +1,12 @@
+-- count_dead.lua
+-- ARGV[1]: field (поле счётчика задач в dead_letter_queue)
+-- KEYS[1]: metrics (хэш метрик)
+-- KEYS[2..]: dead_letter_queue (ключи всех шардов очереди недоставленных задач всех очередей)
+-- Записывает в счётчик, сколько задач сейчас в dead_letter_queue, и возвращает это число
+
+local total = 0
+for i = 2, #KEYS do
+    total = total + redis.call('LLEN', KEYS[i])
+end
+redis.call('HSET', KEYS[1], ARGV[1], total)
+return total
@@ -0,0 +1,21 @@
+-- migrate_dead.lua
+-- ARGV[1]: member (элемент с конца общего dead_letter_queue прежних версий)
+-- ARGV[2]: taskID (идентификатор задачи)
+-- ARGV[3]: taskJSON (запись задачи в текущем формате)
+-- KEYS[1]: legacy_dead_letter_queue (общий список прежних версий с JSON задач)
+-- KEYS[2]: dead_letter_queue (ключ шарда очереди недоставленных задач)
+-- KEYS[3]: task (ключ записи задачи)
+-- Возвращает 1, если задача перенесена в шард, иначе 0
+
+-- Элемент уже забрала другая реплика
+if redis.call('LINDEX', KEYS[1], -1) ~= ARGV[1] then
+    return 0
+end
+redis.call('RPOP', KEYS[1])
+
+-- Задачу, под ID которой уже есть запись, второй раз не переносим
+if not redis.call('SET', KEYS[3], ARGV[3], 'NX') then
+    return 0
+end
+redis.call('LPUSH', KEYS[2], ARGV[2])
+return 1
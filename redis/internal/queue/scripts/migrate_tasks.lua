@@ -0,0 +1,89 @@
+-- migrate_tasks.lua
+-- ARGV[1]: source (priority — элементы priority_queue, delayed — элементы delayed_queue,
+--          processing — элементы списка processing_queue прежних версий)
+-- ARGV[2]: taskKeyPrefix (префикс ключей записей задач)
+-- ARGV[3]: taskType (тип задач, поставленных до появления типов)
+-- ARGV[4..]: members (JSON-элементы очереди в формате прежних версий)
+-- KEYS[1]: priority_queue (ключ приоритетной очереди)
+-- KEYS[2]: delayed_queue (ключ отложенной очереди)
+-- KEYS[3]: sequence (счётчик постановок в priority_queue)
+-- KEYS[4]: notify_queue (ключ списка сигналов о новых задачах)
+-- KEYS[5]: delayed_notify (ключ списка сигналов о новых отложенных задачах)
+-- KEYS[6]: processing_leases (ключ очереди задач в обработке, score — дедлайн аренды в мс)
+-- KEYS[7]: processing_queue (список задач в обработке прежних версий)
+-- Возвращает {сколько задач переведено в записи task:{id}, сколько элементов отброшено}
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local delayed = ARGV[1] == 'delayed'
+local processing = ARGV[1] == 'processing'
+local sourceKey = KEYS[1]
+if delayed then
+    sourceKey = KEYS[2]
+end
+
+-- take удаляет элемент из источника и сообщает, был ли он там
+local function take(member)
+    if processing then
+        return redis.call('LREM', KEYS[7], 1, member) > 0, 0
+    end
+    local legacyScore = redis.call('ZSCORE', sourceKey, member)
+    if not legacyScore then
+        return false, 0
+    end
+    redis.call('ZREM', sourceKey, member)
+    return true, tonumber(legacyScore)
+end
+
+local migrated = 0
+local dropped = 0
+
+for i = 4, #ARGV do
+    local member = ARGV[i]
+    -- Элемент, которого уже нет, перевела другая реплика
+    local found, legacyScore = take(member)
+    if found then
+        local ok, task = pcall(cjson.decode, member)
+        -- Повреждённый элемент прежние версии тоже отбрасывали, а задачу с уже заведённой записью
+        -- второй раз не ставим
+        if not ok or type(task) ~= 'table' or type(task.id) ~= 'string' or task.id == '' or
+            redis.call('EXISTS', ARGV[2] .. task.id) == 1 then
+            dropped = dropped + 1
+        else
+            if type(task.type) ~= 'string' or task.type == '' then
+                task.type = ARGV[3]
+            end
+            -- Без приоритета задачу не вернули бы в очередь ни перенос отложенных задач, ни reaper
+            task.priority = tonumber(task.priority) or 0
+            local retrying = (tonumber(task.attempts) or 0) > 0
+            if processing then
+                -- Задачу, которую выполнял упавший воркер прежней версии, reaper вернёт в очередь
+                -- как попытку с истёкшей арендой
+                redis.call('ZADD', KEYS[6], 0, task.id)
+                transition(task, 'running', now)
+            elseif delayed then
+                -- Прежние версии хранили время выполнения в секундах
+                redis.call('ZADD', KEYS[2], legacyScore * 1000, task.id)
+                transition(task, retrying and 'retrying' or 'scheduled', now)
+            else
+                redis.call('ZADD', KEYS[1], score(KEYS[3], task.priority), task.id)
+                task.queued_at = now
+                transition(task, retrying and 'retrying' or 'queued', now)
+            end
+            redis.call('SET', ARGV[2] .. task.id, cjson.encode(task))
+            migrated = migrated + 1
+        end
+    end
+end
+
+if migrated > 0 and not processing then
+    local notifyKey = KEYS[4]
+    if delayed then
+        notifyKey = KEYS[5]
+    end
+    redis.call('LPUSH', notifyKey, 1)
+    redis.call('LTRIM', notifyKey, 0, 0)
+end
+
+return {migrated, dropped}
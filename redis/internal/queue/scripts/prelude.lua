@@ -0,0 +1,56 @@
+-- prelude.lua
+-- Общие функции скриптов. loadScript добавляет этот файл в начало каждого скрипта,
+-- поэтому кодирование score и записи переходов одинаковы во всех скриптах
+
+-- levelSize размер диапазона score одного уровня приоритета в priority_queue
+local levelSize = 4294967296
+
+-- score возвращает score задачи в priority_queue: старшие разряды — приоритет, младшие — порядковый
+-- номер постановки из счётчика sequenceKey в обратном порядке, чтобы ZPOPMAX внутри приоритета
+-- отдавал задачи по очереди
+local function score(sequenceKey, priority)
+    local sequence = redis.call('INCR', sequenceKey) % levelSize
+    return priority * levelSize + levelSize - 1 - sequence
+end
+
+-- levelRange возвращает границы score задач одного приоритета в priority_queue
+local function levelRange(priority)
+    return priority * levelSize, priority * levelSize + levelSize - 1
+end
+
+-- transition переводит задачу в новое состояние и записывает переход. Откладывание без неудачи
+-- (running → retrying) вытесняет предыдущее такое же, чтобы запись задачи не росла с каждым откладыванием:
+-- хранится только последнее, а переходы с failed остаются все, и их число ограничено попытками
+local function transition(task, state, at)
+    if type(task.transitions) ~= 'table' then
+        task.transitions = {}
+    end
+    local transitions = task.transitions
+    local n = #transitions
+    if state == 'retrying' and n >= 3 and transitions[n].state == 'running' and
+        transitions[n - 1].state == 'retrying' and transitions[n - 2].state == 'running' then
+        table.remove(transitions, n - 1)
+        table.remove(transitions, n - 2)
+    end
+    task.state = state
+    table.insert(transitions, {state = state, at = at})
+end
+
+-- active проверяет, что задача ещё ждёт выполнения или выполняется
+local function active(taskJSON)
+    if not taskJSON then
+        return false
+    end
+    local ok, task = pcall(cjson.decode, taskJSON)
+    if not ok or type(task) ~= 'table' then
+        return false
+    end
+    return task.state == 'queued' or task.state == 'scheduled' or task.state == 'running' or task.state == 'retrying'
+end
+
+-- release снимает блокировку уникальности, если она всё ещё принадлежит задаче
+local function release(task)
+    if type(task.unique_key) == 'string' and redis.call('GET', task.unique_key) == task.id then
+        redis.call('DEL', task.unique_key)
+    end
+end
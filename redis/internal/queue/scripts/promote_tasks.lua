@@ -0,0 +1,60 @@
+-- promote_tasks.lua
+-- ARGV[1]: limit (сколько наступивших задач перенести за один вызов)
+-- ARGV[2]: taskKeyPrefix (префикс ключей записей задач)
+-- KEYS[1]: delayed_queue (ключ отложенной очереди)
+-- KEYS[2]: priority_queue (ключ приоритетной очереди)
+-- KEYS[3]: notify_queue (ключ списка сигналов о новых задачах)
+-- KEYS[4]: dead_letter_queue (ключ очереди недоставленных задач)
+-- KEYS[5]: sequence (счётчик постановок в priority_queue)
+
+local limit = tonumber(ARGV[1])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local due = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', now, 'LIMIT', 0, limit)
+local promoted = 0
+local dead = 0
+
+for _, taskID in ipairs(due) do
+    redis.call('ZREM', KEYS[1], taskID)
+
+    local taskKey = ARGV[2] .. taskID
+    local taskJSON = redis.call('GET', taskKey)
+    -- Если запись задачи пропала, её идентификатор просто отбрасывается
+    if taskJSON then
+        local ok, task = pcall(cjson.decode, taskJSON)
+        if not ok or type(task) ~= 'table' or not tonumber(task.priority) then
+            -- Без приоритета задачу не поставить в очередь, сохраняем её для разбора
+            redis.call('LPUSH', KEYS[4], taskID)
+            dead = dead + 1
+        else
+            -- Задача, ждущая повтора, остаётся в состоянии retrying до взятия воркером
+            if task.state == 'scheduled' then
+                transition(task, 'queued', now)
+            end
+            -- Задача, отложенная ограничением частоты, продолжает ждать с прежнего queued_at
+            if task.rate_limited then
+                task.rate_limited = nil
+            else
+                task.queued_at = now
+            end
+            redis.call('SET', taskKey, cjson.encode(task))
+            redis.call('ZADD', KEYS[2], score(KEYS[5], tonumber(task.priority)), taskID)
+            promoted = promoted + 1
+        end
+    end
+end
+
+if promoted > 0 then
+    redis.call('LPUSH', KEYS[3], 1)
+    redis.call('LTRIM', KEYS[3], 0, 0)
+end
+
+-- Сколько миллисекунд до следующей отложенной задачи (-1, если их нет)
+local nextDue = -1
+local next = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+if next[2] then
+    nextDue = math.max(tonumber(next[2]) - now, 0)
+end
+
+return {promoted, dead, nextDue}
@@ -0,0 +1,26 @@
+-- purge_dead.lua
+-- ARGV[1]: taskID (идентификатор задачи; пустая строка — удалить задачи с конца очереди)
+-- ARGV[2]: limit (сколько задач с конца dead_letter_queue удалить за вызов, если taskID пуст)
+-- ARGV[3]: taskKeyPrefix (префикс ключей записей задач)
+-- KEYS[1]: dead_letter_queue (ключ очереди недоставленных задач)
+-- Возвращает, сколько задач удалено из dead_letter_queue вместе с их записями
+
+if ARGV[1] ~= '' then
+    if redis.call('LREM', KEYS[1], 0, ARGV[1]) == 0 then
+        return 0
+    end
+    redis.call('DEL', ARGV[3] .. ARGV[1])
+    return 1
+end
+
+local removed = 0
+for _ = 1, tonumber(ARGV[2]) do
+    local taskID = redis.call('RPOP', KEYS[1])
+    if not taskID then
+        break
+    end
+    redis.call('DEL', ARGV[3] .. taskID)
+    removed = removed + 1
+end
+
+return removed
@@ -0,0 +1,77 @@
+-- reap_tasks.lua
+-- ARGV[1]: maxAttempts (после стольких попыток задача уходит в dead_letter_queue, если её политика повторов не задаёт своё число)
+-- ARGV[2]: limit (сколько задач с истёкшей арендой обработать за один вызов)
+-- ARGV[3]: taskKeyPrefix (префикс ключей записей задач)
+-- KEYS[1]: processing_leases (ключ очереди задач в обработке, score — дедлайн аренды в мс)
+-- KEYS[2]: leases (хэш токенов аренды задач в обработке)
+-- KEYS[3]: priority_queue (ключ приоритетной очереди)
+-- KEYS[4]: notify_queue (ключ списка сигналов о новых задачах)
+-- KEYS[5]: dead_letter_queue (ключ очереди недоставленных задач)
+-- KEYS[6]: sequence (счётчик постановок в priority_queue)
+
+-- failAttempt завершает последнюю попытку в истории ошибкой истёкшей аренды
+local function failAttempt(task, at)
+    local err = 'task lease expired'
+    task.last_error = err
+    task.failed_at = at
+    if type(task.history) == 'table' and #task.history > 0 then
+        local attempt = task.history[#task.history]
+        attempt.duration = at - (tonumber(attempt.started_at) or at)
+        attempt.error = err
+    end
+end
+
+local maxAttempts = tonumber(ARGV[1])
+local limit = tonumber(ARGV[2])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+local expired = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', now, 'LIMIT', 0, limit)
+local requeued = 0
+local dead = 0
+
+for _, taskID in ipairs(expired) do
+    redis.call('ZREM', KEYS[1], taskID)
+    redis.call('HDEL', KEYS[2], taskID)
+
+    local taskKey = ARGV[3] .. taskID
+    local taskJSON = redis.call('GET', taskKey)
+    -- Если запись задачи пропала, возвращать в очередь нечего
+    if taskJSON then
+        local ok, task = pcall(cjson.decode, taskJSON)
+        if not ok or type(task) ~= 'table' or not tonumber(task.priority) then
+            -- Повреждённую задачу вернуть в очередь нельзя, сохраняем её для разбора
+            redis.call('LPUSH', KEYS[5], taskID)
+            dead = dead + 1
+        else
+            -- Истёкшая аренда считается неудачной попыткой
+            task.attempts = (task.attempts or 0) + 1
+            failAttempt(task, now)
+            transition(task, 'failed', now)
+            local taskMaxAttempts = maxAttempts
+            if type(task.retry) == 'table' and (tonumber(task.retry.max_attempts) or 0) > 0 then
+                taskMaxAttempts = tonumber(task.retry.max_attempts)
+            end
+            if task.attempts >= taskMaxAttempts then
+                transition(task, 'dead', now)
+                release(task)
+                redis.call('LPUSH', KEYS[5], taskID)
+                dead = dead + 1
+            else
+                transition(task, 'retrying', now)
+                task.queued_at = now
+                redis.call('ZADD', KEYS[3], score(KEYS[6], tonumber(task.priority)), taskID)
+                requeued = requeued + 1
+            end
+            redis.call('SET', taskKey, cjson.encode(task))
+        end
+    end
+end
+
+if requeued > 0 then
+    redis.call('LPUSH', KEYS[4], 1)
+    redis.call('LTRIM', KEYS[4], 0, 0)
+end
+
+return {requeued, dead}
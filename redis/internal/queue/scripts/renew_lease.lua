@@ -0,0 +1,31 @@
+-- renew_lease.lua
+-- ARGV[1]: taskID (идентификатор задачи)
+-- ARGV[2]: leaseToken (токен аренды, выданный при взятии задачи)
+-- ARGV[3]: extension (на сколько миллисекунд от текущего момента продлить аренду)
+-- KEYS[1]: processing_leases (ключ очереди задач в обработке, score — дедлайн аренды в мс)
+-- KEYS[2]: leases (хэш токенов аренды задач в обработке)
+
+local extension = tonumber(ARGV[3])
+if not extension then
+    return redis.error_reply("Invalid extension: not a number")
+end
+
+-- Аренда истекла, и задачу уже вернул reaper или взял другой воркер
+if redis.call('HGET', KEYS[2], ARGV[1]) ~= ARGV[2] then
+    return 0
+end
+
+local deadline = redis.call('ZSCORE', KEYS[1], ARGV[1])
+if not deadline then
+    return 0
+end
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+-- Аренду можно только продлить, но не сократить
+if now + extension > tonumber(deadline) then
+    redis.call('ZADD', KEYS[1], 'XX', now + extension, ARGV[1])
+end
+
+return 1
@@ -0,0 +1,92 @@
+-- requeue_dead.lua
+-- ARGV[1]: taskID (идентификатор задачи; пустая строка — повторить задачи с конца очереди)
+-- ARGV[2]: limit (сколько задач с конца dead_letter_queue обработать за вызов, если taskID пуст)
+-- ARGV[3]: taskKeyPrefix (префикс ключей записей задач)
+-- KEYS[1]: dead_letter_queue (ключ очереди недоставленных задач)
+-- KEYS[2]: priority_queue (ключ приоритетной очереди)
+-- KEYS[3]: notify_queue (ключ списка сигналов о новых задачах)
+-- KEYS[4]: sequence (счётчик постановок в priority_queue)
+-- Возвращает {сколько задач возвращено в очередь, сколько идентификаторов удалено из dead_letter_queue,
+-- JSON-строка задачи и итог requeue (только для taskID)}
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+
+-- requeue возвращает задачу в priority_queue со сброшенным счётчиком попыток.
+-- Возвращает 'requeued', 'missing' (записи нет), 'corrupt' (запись не разобрать)
+-- или 'duplicate' (ключ уникальности задачи занят другой активной задачей)
+local function requeue(taskID)
+    local taskKey = ARGV[3] .. taskID
+    local taskJSON = redis.call('GET', taskKey)
+    if not taskJSON then
+        return 'missing', false
+    end
+
+    local ok, task = pcall(cjson.decode, taskJSON)
+    if not ok or type(task) ~= 'table' or not tonumber(task.priority) then
+        return 'corrupt', taskJSON
+    end
+
+    -- Задача снова становится активной и снова берёт блокировку уникальности до завершения.
+    -- Если ключ уже у другой активной задачи, не ставим вторую задачу с тем же ключом
+    if type(task.unique_key) == 'string' then
+        local holder = redis.call('GET', task.unique_key)
+        if holder and holder ~= taskID and active(redis.call('GET', ARGV[3] .. holder)) then
+            return 'duplicate', taskJSON
+        end
+        redis.call('SET', task.unique_key, taskID)
+    end
+
+    task.attempts = 0
+    transition(task, 'queued', now)
+    task.queued_at = now
+    taskJSON = cjson.encode(task)
+    redis.call('SET', taskKey, taskJSON)
+    redis.call('ZADD', KEYS[2], score(KEYS[4], tonumber(task.priority)), taskID)
+    return 'requeued', taskJSON
+end
+
+local requeued = 0
+local removed = 0
+local taskJSON = false
+local status = false
+
+if ARGV[1] ~= '' then
+    if redis.call('LREM', KEYS[1], 0, ARGV[1]) == 0 then
+        return {0, 0, false, 'not_found'}
+    end
+    status, taskJSON = requeue(ARGV[1])
+    if status == 'corrupt' or status == 'duplicate' then
+        -- Повреждённую запись и задачу с занятым ключом уникальности оставляем в очереди
+        redis.call('LPUSH', KEYS[1], ARGV[1])
+    else
+        removed = 1
+        if status == 'requeued' then
+            requeued = 1
+        end
+    end
+else
+    for _ = 1, tonumber(ARGV[2]) do
+        local taskID = redis.call('RPOP', KEYS[1])
+        if not taskID then
+            break
+        end
+        local status = requeue(taskID)
+        if status == 'corrupt' or status == 'duplicate' then
+            -- Переносим в начало очереди: вызывающий обходит не больше исходной длины списка
+            redis.call('LPUSH', KEYS[1], taskID)
+        else
+            removed = removed + 1
+            if status == 'requeued' then
+                requeued = requeued + 1
+            end
+        end
+    end
+end
+
+if requeued > 0 then
+    redis.call('LPUSH', KEYS[3], 1)
+    redis.call('LTRIM', KEYS[3], 0, 0)
+end
+
+return {requeued, removed, taskJSON, status}
@@ -2,11 +2,73 @@ package queue
 
 import "time"
 
+// TaskState состояние задачи в её жизненном цикле
+type TaskState string
+
+const (
+	TaskStateQueued    TaskState = "queued"    // Ждёт воркера в priority_queue
+	TaskStateScheduled TaskState = "scheduled" // Ждёт своего времени в delayed_queue
+	TaskStateRunning   TaskState = "running"   // Выполняется воркером
+	TaskStateRetrying  TaskState = "retrying"  // Попытка не удалась или отложена обработчиком, задача ждёт повтора
+	TaskStateSucceeded TaskState = "succeeded" // Выполнена успешно
+	TaskStateFailed    TaskState = "failed"    // Попытка завершилась ошибкой; сразу за ним следует retrying или dead
+	TaskStateDead      TaskState = "dead"      // Лежит в dead_letter_queue
+	TaskStateCancelled TaskState = "cancelled" // Отменена до начала выполнения
+)
+
+// Transition переход задачи в новое состояние
+type Transition struct {
+	State TaskState `json:"state"`
+	At    int64     `json:"at"` // Unix-время перехода в миллисекундах
+}
+
+// Attempt попытка выполнения задачи
+type Attempt struct {
+	StartedAt int64  `json:"started_at"`      // Unix-время начала попытки в миллисекундах
+	Duration  int64  `json:"duration"`        // Длительность попытки в миллисекундах
+	Error     string `json:"error,omitempty"` // Ошибка, которой завершилась попытка
+	Worker    string `json:"worker"`          // Воркер, выполнявший попытку
+}
+
 // Task представляет задачу в очереди
 type Task struct {
-	ID        string    `json:"id"`
-	Payload   string    `json:"payload"`
-	Priority  int       `json:"priority"`
-	ExecuteAt time.Time `json:"execute_at"`
-	Attempts  int       `json:"attempts"` // Количество попыток выполнения
+	ID          string       `json:"id"`
+	Type        string       `json:"type"`            // Тип задачи, по которому выбирается обработчик
+	Queue       string       `json:"queue,omitempty"` // Именованная очередь; пустая у задач, добавленных до появления очередей
+	Payload     string       `json:"payload"`
+	Priority    int          `json:"priority"`
+	ExecuteAt   time.Time    `json:"execute_at"`
+	Attempts    int          `json:"attempts"`              // Количество попыток выполнения
+	State       TaskState    `json:"state,omitempty"`       // Текущее состояние; переходы выполняют Lua-скрипты
+	Transitions []Transition `json:"transitions,omitempty"` // Все переходы задачи по порядку
+	UniqueKey   string       `json:"unique_key,omitempty"`  // Ключ Redis блокировки уникальности, снимаемой при завершении задачи
+	LastError   string       `json:"last_error,omitempty"`  // Ошибка последней неудачной попытки
+	FailedAt    int64        `json:"failed_at,omitempty"`   // Unix-время последней неудачной попытки в миллисекундах
+	History     []Attempt    `json:"history,omitempty"`     // Последние попытки, не больше history_limit
+	Retry       *RetryPolicy `json:"retry,omitempty"`       // Политика повторов вместо заданной в конфигурации
+	Timeout     int64        `json:"timeout,omitempty"`     // Сколько может выполняться попытка, мс; 0 — по типу задачи или конфигурации
+	QueuedAt    int64        `json:"queued_at,omitempty"`   // Unix-время последней постановки в priority_queue в миллисекундах
+	// Задача отложена ограничением частоты и при возврате в priority_queue сохраняет QueuedAt
+	RateLimited bool `json:"rate_limited,omitempty"`
+	// Unix-время удаления результата выполненной задачи в миллисекундах; 0 — результат не сохранялся
+	ResultExpiresAt int64 `json:"result_expires_at,omitempty"`
+}
+
+// TaskSpec параметры добавляемой задачи
+type TaskSpec struct {
+	Type      string
+	Payload   string
+	Priority  int
+	ExecuteAt time.Time
+	Options   TaskOptions
+}
+
+// TaskOptions необязательные параметры добавления задачи
+type TaskOptions struct {
+	IdempotencyKey string        // Повторное добавление с тем же ключом возвращает исходную задачу
+	UniqueKey      string        // Пока задача с тем же ключом не завершена, новая с ней сливается
+	UniqueFor      time.Duration // Окно уникальности; 0 — до завершения задачи
+	Retry          *RetryPolicy  // Политика повторов задачи; nil — из конфигурации
+	Timeout        time.Duration // Сколько может выполняться попытка; 0 — по типу задачи или конфигурации
+	Queue          string        // Именованная очередь задачи; пустая строка — DefaultQueue
 }
@@ -2,185 +2,398 @@ package queue
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"math"
+	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
-// ProcessTasks запускает воркер для обработки задач
+// ProcessTasks запускает воркеры всех шардов очередей из queues.subscribe и блокируется,
+// пока они не остановятся после отмены ctx. Задачи, прерванные остановкой, возвращаются в очередь
 func (tq *TaskQueue) ProcessTasks(ctx context.Context) {
-	for shard := 0; shard < tq.cfg.Queues.Shards; shard++ {
-		go tq.processShard(ctx, shard)
+	var wg sync.WaitGroup
+	for _, q := range tq.subscribedQueues() {
+		for shard := 0; shard < q.shards; shard++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tq.processShard(ctx, q, shard)
+			}()
+		}
 	}
+	wg.Wait()
 }
 
-// processShard обрабатывает задачи для одного шарда
-func (tq *TaskQueue) processShard(ctx context.Context, shard int) {
-	priorityQueueKey := fmt.Sprintf("%s:%d", tq.cfg.Queues.PriorityKey, shard)
-	delayedQueueKey := fmt.Sprintf("%s:%d", tq.cfg.Queues.DelayedKey, shard)
-	processingQueueKey := fmt.Sprintf("%s:%d", tq.cfg.Queues.ProcessingKey, shard)
+// processShard запускает пул воркеров шарда очереди вместе с переносом
+// отложенных задач и reaper и ждёт их остановки
+func (tq *TaskQueue) processShard(ctx context.Context, q *namedQueue, shard int) {
+	var wg sync.WaitGroup
+	wg.Add(q.workers + 2)
+	go func() {
+		defer wg.Done()
+		tq.processDelayedTasks(ctx, q, shard) // Запускаем обработку отложенных задач
+	}()
+	go func() {
+		defer wg.Done()
+		tq.reapExpiredTasks(ctx, q, shard) // Запускаем возврат задач с истёкшей арендой
+	}()
+	for n := 0; n < q.workers; n++ {
+		go func() {
+			defer wg.Done()
+			tq.runWorker(ctx, q, shard, fmt.Sprintf("%s/%s/%d/%d", tq.worker, q.name, shard, n))
+		}()
+	}
+	wg.Wait()
+
+	tq.logger.Info("Stopped task processing for shard",
+		zap.String("queue", q.name),
+		zap.Int("shard", shard),
+		zap.Int("workers", q.workers))
+}
 
-	go tq.processDelayedTasks(ctx, shard) // Запускаем обработку отложенных задач
+// runWorker забирает задачи шарда и выполняет их по одной, пока не отменён ctx.
+// При заданном queues.concurrency воркер занимает общий слот только на время
+// выполнения задачи, поэтому простаивающие шарды не отнимают слоты у загруженных
+func (tq *TaskQueue) runWorker(ctx context.Context, q *namedQueue, shard int, worker string) {
+	keys := tq.keys(q, shard)
 
 	for {
-		select {
-		case <-ctx.Done():
-			tq.logger.Info("Stopping task processing for shard due to context cancellation",
-				zap.Int("shard", shard))
+		if !tq.acquireSlot(ctx) {
 			return
-		default:
-			// Извлекаем задачу с наивысшим приоритетом
-			result, err := tq.client.BZPopMax(ctx, 0, priorityQueueKey).Result()
-			if err != nil {
-				tq.logger.Error("Error popping task from shard",
-					zap.Int("shard", shard),
-					zap.Error(err))
-				time.Sleep(time.Second)
-				continue
-			}
+		}
 
-			// Переносим задачу в processing_queue
-			taskJSON := result.Member.(string)
-			err = tq.client.LPush(ctx, processingQueueKey, taskJSON).Err()
-			if err != nil {
-				tq.logger.Error("Error moving task to processing queue",
-					zap.Int("shard", shard),
-					zap.Error(err))
-				continue
+		// Атомарно забираем задачу с наивысшим приоритетом в processing_leases
+		l, err := tq.claimTask(ctx, q, shard, worker)
+		if err != nil {
+			tq.releaseSlot()
+			if ctx.Err() != nil {
+				return
 			}
-
-			// Десериализуем задачу
-			var task Task
-			if err := json.Unmarshal([]byte(taskJSON), &task); err != nil {
-				tq.logger.Error("Error unmarshaling task",
-					zap.Int("shard", shard),
-					zap.Error(err))
-				tq.client.LRem(ctx, processingQueueKey, 1, taskJSON)
+			if errors.Is(err, redis.Nil) {
+				tq.waitForTask(ctx, keys, shard)
 				continue
 			}
-
-			// Обрабатываем задачу
-			err = tq.processTask(task)
-			if err != nil {
-				tq.logger.Error("Error processing task",
-					zap.String("task_id", task.ID),
-					zap.Int("shard", shard),
-					zap.Int("attempt", task.Attempts+1),
-					zap.Error(err))
-				task.Attempts++
-				if task.Attempts >= tq.cfg.Retry.MaxAttempts {
-					// Перемещаем в dead_letter_queue
-					tq.client.LPush(ctx, "dead_letter_queue", taskJSON)
-					tq.logger.Warn("Task moved to dead_letter_queue after max attempts",
-						zap.String("task_id", task.ID),
-						zap.Int("attempts", task.Attempts))
-					tq.metrics.IncrementDeadLetter(ctx)
-				} else {
-					// Вычисляем задержку с экспоненциальным backoff
-					delay := time.Duration(tq.cfg.Retry.BackoffInitial) * time.Millisecond
-					delay = delay * time.Duration(math.Pow(float64(tq.cfg.Retry.BackoffFactor), float64(task.Attempts-1)))
-					task.ExecuteAt = time.Now().Add(delay)
-					taskJSONbb, _ := json.Marshal(task)
-					taskJSON = string(taskJSONbb)
-					// Возвращаем задачу в delayed_queue
-					tq.client.ZAdd(ctx, delayedQueueKey, redis.Z{
-						Score:  float64(task.ExecuteAt.Unix()),
-						Member: taskJSON,
-					})
-					tq.logger.Info("Task scheduled for retry",
-						zap.String("task_id", task.ID),
-						zap.Duration("delay", delay),
-						zap.Int("attempt", task.Attempts))
+			if errors.Is(err, errShardPaused) {
+				// Сигналы о новых задачах приходят и во время паузы, поэтому ждём весь block_timeout
+				select {
+				case <-ctx.Done():
+				case <-time.After(time.Duration(tq.cfg.Queues.BlockTimeout) * time.Millisecond):
 				}
-			} else {
-				tq.logger.Info("Task processed successfully",
-					zap.String("task_id", task.ID),
-					zap.Int("shard", shard))
-				tq.metrics.IncrementSuccess(ctx)
+				continue
 			}
+			tq.logger.Error("Error claiming task from shard",
+				zap.String("queue", q.name),
+				zap.Int("shard", shard),
+				zap.Error(err))
+			time.Sleep(time.Second)
+			continue
+		}
+
+		tq.runTask(ctx, l, shard)
+		tq.releaseSlot()
+	}
+}
+
+// runTask выполняет взятую задачу и подтверждает её итог. Подтверждение выполняется и после отмены ctx,
+// чтобы остановка воркера не оставляла задачу до истечения аренды
+func (tq *TaskQueue) runTask(ctx context.Context, l *lease, shard int) {
+	ackCtx := context.WithoutCancel(ctx)
+
+	// Обрабатываем задачу
+	result, err := tq.processTask(ctx, l)
+	l.result = result
+
+	// Прерванная остановкой задача возвращается в очередь без расхода попытки
+	if err != nil && ctx.Err() != nil {
+		l.finishAttempt(RetryAfter(0, err))
+		tq.deferTask(ackCtx, l, 0)
+		return
+	}
 
-			// Удаляем задачу из processing_queue
-			tq.client.LRem(ctx, processingQueueKey, 1, taskJSON)
-			tq.metrics.IncrementTotalProcessed(ctx)
+	l.finishAttempt(err)
+	var retryAfter *RetryAfterError
+	switch {
+	case err == nil:
+		if tq.ackTask(ackCtx, l, ackDone) {
+			tq.logger.Info("Task processed successfully",
+				zap.String("task_id", l.task.ID),
+				zap.Int("shard", shard))
+			tq.metrics.IncrementSuccess(ackCtx)
 		}
+	case isPermanent(err):
+		// Повтор не поможет: сразу отправляем задачу в dead_letter_queue
+		tq.metrics.IncrementPermanentFailed(ackCtx)
+		tq.moveToDeadLetter(ackCtx, l, err.Error())
+	case errors.As(err, &retryAfter):
+		tq.metrics.IncrementRetryDeferred(ackCtx)
+		tq.deferTask(ackCtx, l, retryAfter.Delay)
+	default:
+		tq.logger.Error("Error processing task",
+			zap.String("task_id", l.task.ID),
+			zap.Int("shard", shard),
+			zap.Int("attempt", l.task.Attempts+1),
+			zap.Error(err))
+		tq.metrics.IncrementFailed(ackCtx)
+		tq.retryTask(ackCtx, l)
+	}
+
+	tq.metrics.IncrementTotalProcessed(ackCtx)
+}
+
+// acquireSlot занимает слот общего ограничения queues.concurrency.
+// Возвращает false, если ctx отменён раньше, чем слот освободился
+func (tq *TaskQueue) acquireSlot(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if tq.slots == nil {
+		return true
+	}
+	select {
+	case tq.slots <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// releaseSlot освобождает слот, занятый acquireSlot
+func (tq *TaskQueue) releaseSlot() {
+	if tq.slots != nil {
+		<-tq.slots
 	}
 }
 
-// processTask выполняет задачу (здесь заглушка с возможной ошибкой)
-func (tq *TaskQueue) processTask(task Task) error {
+// retryTask планирует повтор задачи с экспоненциальным backoff по её политике повторов
+// или отправляет её в dead_letter_queue после исчерпания попыток
+func (tq *TaskQueue) retryTask(ctx context.Context, l *lease) {
+	policy := tq.retryPolicy(l.task)
+	l.task.Attempts++
+	if l.task.Attempts >= policy.MaxAttempts {
+		tq.moveToDeadLetter(ctx, l, "max attempts exceeded")
+		return
+	}
+
+	delay := policy.backoff(l.task.Attempts)
+	l.task.ExecuteAt = time.Now().Add(delay)
+
+	// Возвращаем задачу в delayed_queue
+	if !tq.ackTask(ctx, l, ackRetry) {
+		return
+	}
+	tq.logger.Info("Task scheduled for retry",
+		zap.String("task_id", l.task.ID),
+		zap.Duration("delay", delay),
+		zap.Int("attempt", l.task.Attempts))
+}
+
+// deferTask откладывает задачу на delay, не расходуя попытку
+func (tq *TaskQueue) deferTask(ctx context.Context, l *lease, delay time.Duration) {
+	l.task.ExecuteAt = time.Now().Add(delay)
+	if !tq.ackTask(ctx, l, ackDefer) {
+		return
+	}
+	tq.logger.Info("Task deferred",
+		zap.String("task_id", l.task.ID),
+		zap.Duration("delay", delay),
+		zap.Int("attempts", l.task.Attempts))
+}
+
+// waitForTask блокируется до сигнала о новой задаче в шарде или до истечения block_timeout
+func (tq *TaskQueue) waitForTask(ctx context.Context, keys shardKeys, shard int) {
+	timeout := time.Duration(tq.cfg.Queues.BlockTimeout) * time.Millisecond
+	err := tq.client.BLPop(ctx, timeout, keys.notify).Err()
+	if err != nil && !errors.Is(err, redis.Nil) && ctx.Err() == nil {
+		tq.logger.Error("Error waiting for task in shard",
+			zap.Int("shard", shard),
+			zap.Error(err))
+		time.Sleep(time.Second)
+	}
+}
+
+// processTask выполняет задачу обработчиком, зарегистрированным для её типа, и возвращает результат.
+// Пока обработчик работает, аренда задачи продлевается в фоне; если продлить её
+// не удалось, контекст обработчика отменяется с причиной ErrLeaseLost.
+// По истечении таймаута задачи контекст отменяется с причиной ErrTaskTimeout. После отмены воркер
+// дожидается возврата обработчика, продолжая продлевать аренду, поэтому задачу не выполняют
+// одновременно два обработчика, а слот concurrency занят, пока обработчик работает.
+// Итог попытки — то, что вернул обработчик; его ошибка дополняется причиной отмены
+func (tq *TaskQueue) processTask(ctx context.Context, l *lease) (string, error) {
+	handler, ok := tq.registry.Handler(l.task.Type)
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownTaskType, l.task.Type)
+	}
+
+	handlerCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	l.cancel = cancel
+	timeout := tq.taskTimeout(l.task)
+	if timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		handlerCtx, cancelTimeout = context.WithTimeoutCause(handlerCtx, timeout,
+			fmt.Errorf("%w after %s", ErrTaskTimeout, timeout))
+		defer cancelTimeout()
+	}
+	handlerCtx = context.WithValue(handlerCtx, leaseKey{}, l)
+	// Аренда продлевается, пока обработчик не вернётся, даже после отмены его контекста
+	leaseCtx, stopHeartbeat := context.WithCancel(context.WithoutCancel(ctx))
+	defer stopHeartbeat()
+	go l.heartbeat(leaseCtx)
+
 	tq.logger.Debug("Processing task",
-		zap.String("task_id", task.ID),
-		zap.String("payload", task.Payload),
-		zap.Int("attempt", task.Attempts+1))
-	// Имитация обработки
-	time.Sleep(100 * time.Millisecond)
-	return nil
+		zap.String("task_id", l.task.ID),
+		zap.String("task_type", l.task.Type),
+		zap.String("payload", l.task.Payload),
+		zap.Int("attempt", l.task.Attempts+1),
+		zap.Duration("timeout", timeout))
+
+	type handlerResult struct {
+		result string
+		err    error
+	}
+	done := make(chan handlerResult, 1)
+	go func() {
+		result, err := handler(handlerCtx, l.task)
+		done <- handlerResult{result: result, err: err}
+	}()
+
+	select {
+	case res := <-done:
+		return res.result, res.err
+	case <-handlerCtx.Done():
+	}
+
+	// Попытка завершается, только когда обработчик вернётся: иначе задачу
+	// взял бы другой воркер, пока этот обработчик ещё выполняет её
+	cause := context.Cause(handlerCtx)
+	stuck := time.NewTimer(handlerStopWarning)
+	defer stuck.Stop()
+	var res handlerResult
+	select {
+	case res = <-done:
+	case <-stuck.C:
+		tq.logger.Warn("Handler ignores cancellation, waiting for it to return",
+			zap.String("task_id", l.task.ID),
+			zap.String("task_type", l.task.Type),
+			zap.NamedError("cause", cause))
+		res = <-done
+	}
+	// Обработчик, успевший выполнить задачу, несмотря на отмену, её выполнил: повторять нечего.
+	// К ошибке добавляется причина отмены, чтобы по ней можно было узнать ErrTaskTimeout и ErrLeaseLost
+	if res.err == nil || errors.Is(res.err, cause) {
+		return res.result, res.err
+	}
+	return res.result, fmt.Errorf("%w: %w", cause, res.err)
 }
 
-// processDelayedTasks переносит отложенные задачи в priority_queue
-func (tq *TaskQueue) processDelayedTasks(ctx context.Context, shard int) {
-	delayedQueueKey := fmt.Sprintf("%s:%d", tq.cfg.Queues.DelayedKey, shard)
-	priorityQueueKey := fmt.Sprintf("%s:%d", tq.cfg.Queues.PriorityKey, shard)
+// taskTimeout возвращает таймаут попытки: заданный для задачи, для её типа или общий
+func (tq *TaskQueue) taskTimeout(task Task) time.Duration {
+	if task.Timeout > 0 {
+		return time.Duration(task.Timeout) * time.Millisecond
+	}
+	if typeCfg, ok := tq.cfg.TaskTypes[task.Type]; ok && typeCfg.Timeout > 0 {
+		return time.Duration(typeCfg.Timeout) * time.Millisecond
+	}
+	return time.Duration(tq.cfg.Queues.TaskTimeout) * time.Millisecond
+}
+
+// moveToDeadLetter перемещает задачу в dead_letter_queue с указанием причины
+func (tq *TaskQueue) moveToDeadLetter(ctx context.Context, l *lease, reason string) {
+	if !tq.ackTask(ctx, l, ackDead) {
+		return
+	}
+	tq.logger.Warn("Task moved to dead_letter_queue",
+		zap.String("task_id", l.task.ID),
+		zap.String("task_type", l.task.Type),
+		zap.Int("attempts", l.task.Attempts),
+		zap.String("reason", reason))
+	tq.metrics.IncrementDeadLetter(ctx)
+}
+
+// processDelayedTasks переносит отложенные задачи, чьё время наступило, в priority_queue.
+// Перенос выполняется одним Lua-скриптом, поэтому при нескольких репликах
+// каждая задача продвигается ровно один раз
+func (tq *TaskQueue) processDelayedTasks(ctx context.Context, q *namedQueue, shard int) {
+	keys := tq.keys(q, shard)
 
 	for {
 		select {
 		case <-ctx.Done():
 			tq.logger.Info("Stopping delayed task processing for shard due to context cancellation",
+				zap.String("queue", q.name),
 				zap.Int("shard", shard))
 			return
 		default:
-			now := time.Now().Unix()
-			// Извлекаем задачи, чьё время выполнения наступило
-			tasks, err := tq.client.ZRangeByScore(ctx, delayedQueueKey, &redis.ZRangeBy{
-				Min:    "-inf",
-				Max:    fmt.Sprintf("%d", now),
-				Offset: 0,
-				Count:  100,
-			}).Result()
+			result, err := tq.promoteTasksScript.Run(ctx, tq.client,
+				[]string{keys.delayed, keys.priority, keys.notify, keys.deadLetter, keys.sequence},
+				batchSize, tq.taskKey("")).Int64Slice()
 			if err != nil {
-				tq.logger.Error("Error fetching delayed tasks",
+				tq.logger.Error("Error promoting delayed tasks",
+					zap.String("queue", q.name),
 					zap.Int("shard", shard),
 					zap.Error(err))
 				time.Sleep(time.Second)
 				continue
 			}
 
-			for _, taskJSON := range tasks {
-				var task Task
-				if err := json.Unmarshal([]byte(taskJSON), &task); err != nil {
-					tq.logger.Error("Error unmarshaling delayed task",
-						zap.Int("shard", shard),
-						zap.Error(err))
-					continue
-				}
-
-				// Переносим задачу в priority_queue
-				err = tq.client.ZAdd(ctx, priorityQueueKey, redis.Z{
-					Score:  float64(task.Priority),
-					Member: taskJSON,
-				}).Err()
-				if err != nil {
-					tq.logger.Error("Error moving delayed task to priority queue",
-						zap.Int("shard", shard),
-						zap.Error(err))
-					continue
+			promoted, dead, nextDue := result[0], result[1], result[2]
+			if promoted > 0 {
+				tq.logger.Debug("Moved delayed tasks to priority queue",
+					zap.String("queue", q.name),
+					zap.Int("shard", shard),
+					zap.Int64("count", promoted))
+			}
+			if dead > 0 {
+				tq.logger.Error("Moved malformed delayed tasks to dead_letter_queue",
+					zap.String("queue", q.name),
+					zap.Int("shard", shard),
+					zap.Int64("count", dead))
+				for i := int64(0); i < dead; i++ {
+					tq.metrics.IncrementDeadLetter(ctx)
 				}
-
-				// Удаляем задачу из delayed_queue
-				tq.client.ZRem(ctx, delayedQueueKey, taskJSON)
-				tq.logger.Debug("Moved delayed task to priority queue",
-					zap.String("task_id", task.ID),
-					zap.Int("shard", shard))
 			}
 
-			// Ждём следующую задачу или 1 секунду
-			if len(tasks) == 0 {
-				time.Sleep(time.Second)
+			// Ждём наступления следующей отложенной задачи
+			if promoted+dead == 0 {
+				tq.waitForDelayed(ctx, keys, shard, time.Duration(nextDue)*time.Millisecond)
 			}
 		}
 	}
 }
+
+// waitForDelayed ждёт до наступления следующей отложенной задачи, но не дольше block_timeout.
+// Новая отложенная задача прерывает ожидание сигналом в delayed_notify, чтобы короткие
+// задержки не ждали окончания уже начатого ожидания; ожидание короче секунды не прерывается.
+// nextDue < 0 означает, что задач нет
+func (tq *TaskQueue) waitForDelayed(ctx context.Context, keys shardKeys, shard int, nextDue time.Duration) {
+	wait := time.Duration(tq.cfg.Queues.BlockTimeout) * time.Millisecond
+	if nextDue >= 0 && nextDue < wait {
+		wait = nextDue
+	}
+	if wait < time.Millisecond {
+		return
+	}
+
+	// BLPop из go-redis передаёт таймаут целыми секундами, поэтому ожидание короче секунды
+	// выдерживаем сами, а более длинное округляем вниз: проснуться раньше срока безопасно
+	if wait < time.Second {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+		return
+	}
+	err := tq.client.BLPop(ctx, wait.Truncate(time.Second), keys.delayedNotify).Err()
+	if err != nil && !errors.Is(err, redis.Nil) && ctx.Err() == nil {
+		tq.logger.Error("Error waiting for delayed tasks in shard",
+			zap.Int("shard", shard),
+			zap.Error(err))
+		time.Sleep(time.Second)
+	}
+}
@@ -2,15 +2,19 @@ package redis
 
 import (
 	"context"
+	"runtime"
 
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
 
-// NewClient создаёт новый Redis-клиент
-func NewClient(ctx context.Context, addr string) (*redis.Client, error) {
+// NewClient создаёт новый Redis-клиент. blocking — сколько соединений одновременно заняты
+// блокирующими командами; пул увеличивается на это число сверх размера по умолчанию,
+// чтобы ждущие воркеры не отнимали соединения у API и подтверждения задач
+func NewClient(ctx context.Context, addr string, blocking int) (*redis.Client, error) {
 	client := redis.NewClient(&redis.Options{
-		Addr: addr,
+		Addr:     addr,
+		PoolSize: 10*runtime.GOMAXPROCS(0) + blocking,
 	})
 
 	// Проверяем соединение